@@ -0,0 +1,34 @@
+package graphs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBitset(t *testing.T) {
+	b := newBitset(130)
+	assert.Equal(t, 3, len(b))
+
+	b.set(0)
+	b.set(64)
+	b.set(129)
+	assert.True(t, b.has(0))
+	assert.True(t, b.has(64))
+	assert.True(t, b.has(129))
+	assert.False(t, b.has(1))
+	assert.False(t, b.has(128))
+	assert.Equal(t, 3, b.count())
+}
+
+func TestBitsetOr(t *testing.T) {
+	a := newBitset(100)
+	b := newBitset(100)
+	a.set(3)
+	b.set(70)
+	a.or(b)
+	assert.True(t, a.has(3))
+	assert.True(t, a.has(70))
+	assert.False(t, b.has(3))
+	assert.Equal(t, 2, a.count())
+}
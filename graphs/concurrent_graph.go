@@ -0,0 +1,125 @@
+package graphs
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+// ConcurrentGraph wraps a Graph so one goroutine can mutate it while others query
+// it. Writers take an exclusive lock. Readers never search the live graph; they
+// take a GraphSnapshot, an immutable CSR copy that stays valid and consistent no
+// matter what writers do afterwards. The snapshot is cached until the next write,
+// so repeated reads between writes share one copy.
+type ConcurrentGraph struct {
+	mu       sync.RWMutex
+	graph    *Graph
+	snapshot atomic.Pointer[GraphSnapshot] // nil when a write has invalidated it
+}
+
+// GraphSnapshot is a frozen view of a ConcurrentGraph. Node i of the CSR graph is
+// nodes[i]; the *Node values are only used as identifiers and never read.
+type GraphSnapshot struct {
+	csr   *CSRGraph
+	nodes []*Node
+	index map[*Node]int
+}
+
+func newConcurrentGraph() *ConcurrentGraph {
+	return &ConcurrentGraph{graph: &Graph{}}
+}
+
+// write runs fn with the write lock held and drops the cached snapshot
+func (c *ConcurrentGraph) write(fn func(g *Graph)) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	fn(c.graph)
+	c.snapshot.Store(nil)
+}
+
+func (c *ConcurrentGraph) addNode(value int) *Node {
+	var node *Node
+	c.write(func(g *Graph) { node = g.addNode(value) })
+	return node
+}
+
+func (c *ConcurrentGraph) removeNode(node *Node) {
+	c.write(func(g *Graph) { g.removeNode(node) })
+}
+
+func (c *ConcurrentGraph) addEdge(node1, node2 *Node) {
+	c.write(func(g *Graph) { g.addEdge(node1, node2) })
+}
+
+func (c *ConcurrentGraph) addWeightedEdge(node1, node2 *Node, weight int) {
+	c.write(func(g *Graph) { g.addWeightedEdge(node1, node2, weight) })
+}
+
+func (c *ConcurrentGraph) removeEdge(node1, node2 *Node) {
+	c.write(func(g *Graph) { g.removeEdge(node1, node2) })
+}
+
+// takeSnapshot returns the cached snapshot, building it under the read lock if a
+// write has happened since the last one. Storing it while still holding the read
+// lock guarantees a writer cannot slip in between building and caching it.
+//
+// Time Complexity: O(1) if unchanged since the last snapshot, otherwise O(V+E)
+// Space Complexity: O(V+E) per distinct snapshot
+func (c *ConcurrentGraph) takeSnapshot() *GraphSnapshot {
+	if s := c.snapshot.Load(); s != nil {
+		return s
+	}
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	if s := c.snapshot.Load(); s != nil {
+		return s
+	}
+	s := &GraphSnapshot{
+		csr:   c.graph.freeze(),
+		nodes: append([]*Node(nil), c.graph.nodes...),
+		index: c.graph.nodeIndex(),
+	}
+	c.snapshot.Store(s)
+	return s
+}
+
+func (s *GraphSnapshot) contains(node *Node) bool {
+	_, ok := s.index[node]
+	return ok
+}
+
+// dijkstra behaves like the package level dijkstra on the graph as it was when
+// the snapshot was taken. Nodes not in the snapshot are unreachable.
+func (s *GraphSnapshot) dijkstra(start, target *Node) ([]*Node, int) {
+	i, ok := s.index[start]
+	j, ok2 := s.index[target]
+	if !ok || !ok2 {
+		return nil, -1
+	}
+	path, distance := viewDijkstra(s.csr, i, j)
+	if path == nil {
+		return nil, distance
+	}
+	nodes := make([]*Node, len(path))
+	for k, p := range path {
+		nodes[k] = s.nodes[p]
+	}
+	return nodes, distance
+}
+
+func (s *GraphSnapshot) breadthFirstSearch(start, target *Node) bool {
+	i, ok := s.index[start]
+	j, ok2 := s.index[target]
+	if !ok || !ok2 {
+		return false
+	}
+	return bfsLevels(s.csr, i)[j] >= 0
+}
+
+// bfsDistances returns edge counts from source indexed like s.nodes, -1 if unreachable
+func (s *GraphSnapshot) bfsDistances(source *Node) []int {
+	i, ok := s.index[source]
+	if !ok {
+		return unreachableDistances(len(s.nodes))
+	}
+	return bfsLevels(s.csr, i)
+}
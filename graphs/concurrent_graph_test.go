@@ -0,0 +1,118 @@
+package graphs
+
+import (
+	"math/rand"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConcurrentGraphSnapshot(t *testing.T) {
+	c := newConcurrentGraph()
+	node1 := c.addNode(1)
+	node2 := c.addNode(2)
+	node3 := c.addNode(3)
+	c.addWeightedEdge(node1, node2, 2)
+	c.addWeightedEdge(node2, node3, 3)
+
+	before := c.takeSnapshot()
+	assert.Same(t, before, c.takeSnapshot()) // cached until a write
+
+	c.addWeightedEdge(node1, node3, 1)
+	c.removeEdge(node2, node3)
+	node4 := c.addNode(4)
+	after := c.takeSnapshot()
+	assert.NotSame(t, before, after)
+
+	// The old snapshot still sees the old graph
+	path, distance := before.dijkstra(node1, node3)
+	assert.Equal(t, []*Node{node1, node2, node3}, path)
+	assert.Equal(t, 5, distance)
+	assert.False(t, before.contains(node4))
+	assert.True(t, before.breadthFirstSearch(node2, node3))
+
+	path, distance = after.dijkstra(node1, node3)
+	assert.Equal(t, []*Node{node1, node3}, path)
+	assert.Equal(t, 1, distance)
+	assert.False(t, after.breadthFirstSearch(node2, node3))
+	assert.Equal(t, []int{0, 1, 1, -1}, after.bfsDistances(node1))
+
+	c.removeNode(node2)
+	latest := c.takeSnapshot()
+	assert.False(t, latest.contains(node2))
+	path, distance = latest.dijkstra(node1, node2)
+	assert.Nil(t, path)
+	assert.Equal(t, -1, distance)
+	assert.False(t, latest.breadthFirstSearch(node2, node1))
+	assert.Equal(t, []int{-1, -1, -1}, latest.bfsDistances(node2))
+}
+
+// Run with -race: writers add and remove nodes and edges while readers search
+// snapshots, and every answer must be consistent with the snapshot it came from.
+func TestConcurrentGraphStress(t *testing.T) {
+	c := newConcurrentGraph()
+	var mu sync.Mutex
+	var live []*Node
+	for i := 0; i < 50; i++ {
+		live = append(live, c.addNode(i))
+	}
+
+	var wg sync.WaitGroup
+	for w := 0; w < 2; w++ {
+		wg.Go(func() {
+			r := rand.New(rand.NewSource(int64(w)))
+			for i := 0; i < 500; i++ {
+				mu.Lock()
+				a, b := live[r.Intn(len(live))], live[r.Intn(len(live))]
+				mu.Unlock()
+				switch r.Intn(4) {
+				case 0, 1:
+					c.addWeightedEdge(a, b, 1+r.Intn(9))
+				case 2:
+					c.removeEdge(a, b)
+				case 3:
+					n := c.addNode(1000 + i)
+					mu.Lock()
+					live = append(live, n)
+					mu.Unlock()
+					c.removeNode(a)
+				}
+			}
+		})
+	}
+	for reader := 0; reader < 4; reader++ {
+		wg.Go(func() {
+			r := rand.New(rand.NewSource(int64(100 + reader)))
+			for i := 0; i < 300; i++ {
+				s := c.takeSnapshot()
+				if len(s.nodes) == 0 {
+					continue
+				}
+				a, b := s.nodes[r.Intn(len(s.nodes))], s.nodes[r.Intn(len(s.nodes))]
+				path, distance := s.dijkstra(a, b)
+				if path == nil {
+					assert.Equal(t, -1, distance)
+					assert.False(t, s.breadthFirstSearch(a, b))
+					continue
+				}
+				assert.True(t, s.breadthFirstSearch(a, b))
+				// Every hop is an edge of the snapshot and the weights add up
+				total := 0
+				for k := 0; k+1 < len(path); k++ {
+					targets, weights := s.csr.edgesFrom(s.index[path[k]])
+					best := -1
+					for e, tgt := range targets {
+						if int(tgt) == s.index[path[k+1]] && (best == -1 || weights[e] < best) {
+							best = weights[e]
+						}
+					}
+					assert.NotEqual(t, -1, best)
+					total += best
+				}
+				assert.Equal(t, distance, total)
+			}
+		})
+	}
+	wg.Wait()
+}
@@ -0,0 +1,156 @@
+package graphs
+
+import "fmt"
+
+// Schedule holds the result of critical path analysis on a task graph where an
+// edge u -> v of weight w means v cannot start until u has finished, and w is
+// u's duration. Every edge leaving a task must carry the same weight. A task with
+// no outgoing edges has no duration of its own: it is a milestone, so give the
+// last real task an edge to a finish milestone to count its duration.
+type Schedule struct {
+	earliestStart map[*Node]int
+	latestStart   map[*Node]int
+	finish        int // earliest time by which every task has finished
+	criticalPath  []*Node
+}
+
+// slack is how far a task can be delayed without delaying the whole project.
+// Tasks on the critical path have zero slack.
+func (s *Schedule) slack(n *Node) int {
+	return s.latestStart[n] - s.earliestStart[n]
+}
+
+// Longest path in a DAG - relax edges in topological order keeping the maximum
+// instead of the minimum. Returns the path, its length, and a *CycleError
+// (wrapped) if the graph is not acyclic since longest paths are then unbounded.
+//
+// Time Complexity: O(V+E)
+// Space Complexity: O(V)
+func (g *Graph) longestPath() ([]*Node, int, error) {
+	order, err := g.topologicalSort()
+	if err != nil {
+		return nil, 0, fmt.Errorf("longest path needs a DAG: %w", err)
+	}
+	if len(order) == 0 {
+		return nil, 0, nil
+	}
+
+	distance, previous := g.earliestStarts(order)
+	end := order[0]
+	for _, n := range order {
+		if distance[n] > distance[end] {
+			end = n
+		}
+	}
+	return reconstructPath(previous, end), distance[end], nil
+}
+
+// earliestStarts computes, for nodes in topological order, the longest distance
+// from any source and the predecessor on that longest path
+func (g *Graph) earliestStarts(order []*Node) (map[*Node]int, map[*Node]*Node) {
+	index := g.nodeIndex()
+	distance := make(map[*Node]int, len(order))
+	previous := make(map[*Node]*Node)
+	for _, n := range order {
+		if _, ok := distance[n]; !ok {
+			distance[n] = 0 // no predecessors, can start immediately
+		}
+		for _, adj := range n.adjacent {
+			if _, ok := index[adj]; !ok {
+				continue // edge to a node that is not in g
+			}
+			d := distance[n] + n.edges[adj]
+			if best, ok := distance[adj]; !ok || d > best {
+				distance[adj] = d
+				previous[adj] = n
+			}
+		}
+	}
+	return distance, previous
+}
+
+// taskDurations reads each task's duration off the weights of its outgoing edges,
+// reporting an error if they disagree or are negative. Milestones are left out.
+func (g *Graph) taskDurations() (map[*Node]int, error) {
+	index := g.nodeIndex()
+	duration := make(map[*Node]int, len(g.nodes))
+	for _, n := range g.nodes {
+		for _, adj := range n.adjacent {
+			if _, ok := index[adj]; !ok {
+				continue // edge to a node that is not in g
+			}
+			w := n.edges[adj]
+			if w < 0 {
+				return nil, fmt.Errorf("critical path: task %d has negative duration %d", n.value, w)
+			}
+			if d, ok := duration[n]; ok && d != w {
+				return nil, fmt.Errorf("critical path: task %d has conflicting durations %d and %d", n.value, d, w)
+			}
+			duration[n] = w
+		}
+	}
+	return duration, nil
+}
+
+// Critical path method - a forward pass in topological order gives each task's
+// earliest start, a backward pass from the project finish gives the latest start
+// that still finishes on time, and their difference is the slack. The critical
+// path is the chain of zero-slack tasks that ends last, and the finish is the
+// length of the longest path, as longestPath reports for the same graph.
+//
+// Time Complexity: O(V+E)
+// Space Complexity: O(V)
+func (g *Graph) criticalPath() (*Schedule, error) {
+	order, err := g.topologicalSort()
+	if err != nil {
+		return nil, fmt.Errorf("critical path needs a DAG: %w", err)
+	}
+	duration, err := g.taskDurations()
+	if err != nil {
+		return nil, err
+	}
+
+	index := g.nodeIndex()
+	schedule := &Schedule{
+		earliestStart: make(map[*Node]int, len(order)),
+		latestStart:   make(map[*Node]int, len(order)),
+	}
+	previous := make(map[*Node]*Node)
+	var end *Node
+	for _, n := range order {
+		if _, ok := schedule.earliestStart[n]; !ok {
+			schedule.earliestStart[n] = 0 // no predecessors, can start immediately
+		}
+		finish := schedule.earliestStart[n] + duration[n]
+		// ties go to the later task so trailing milestones end the critical path
+		if end == nil || finish >= schedule.finish {
+			end, schedule.finish = n, finish
+		}
+		for _, adj := range n.adjacent {
+			if _, ok := index[adj]; !ok {
+				continue // edge to a node that is not in g
+			}
+			if _, ok := previous[adj]; !ok || finish > schedule.earliestStart[adj] {
+				schedule.earliestStart[adj] = finish
+				previous[adj] = n
+			}
+		}
+	}
+	if end == nil {
+		return schedule, nil
+	}
+
+	for i := len(order) - 1; i >= 0; i-- {
+		n := order[i]
+		latestFinish := schedule.finish
+		for _, adj := range n.adjacent {
+			if _, ok := index[adj]; ok {
+				latestFinish = min(latestFinish, schedule.latestStart[adj])
+			}
+		}
+		schedule.latestStart[n] = latestFinish - duration[n]
+	}
+
+	schedule.criticalPath = reconstructPath(previous, end)
+	return schedule, nil
+}
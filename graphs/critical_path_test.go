@@ -0,0 +1,172 @@
+package graphs
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// Project plan, edge weights are the duration of the task the edge leaves and
+// release is the finish milestone:
+//
+//	start(0) -> design(3) -> build(5) -> test(2) -> release
+//	start(0) -> docs(4) ------------------------------^
+//	design(3) -> review(1) -> test
+func buildProjectPlan() (*Graph, map[string]*Node) {
+	g := &Graph{}
+	tasks := map[string]*Node{}
+	for i, name := range []string{"start", "design", "build", "review", "docs", "test", "release"} {
+		tasks[name] = g.addNode(i)
+	}
+	g.addWeightedEdge(tasks["start"], tasks["design"], 0)
+	g.addWeightedEdge(tasks["start"], tasks["docs"], 0)
+	g.addWeightedEdge(tasks["design"], tasks["build"], 3)
+	g.addWeightedEdge(tasks["design"], tasks["review"], 3)
+	g.addWeightedEdge(tasks["build"], tasks["test"], 5)
+	g.addWeightedEdge(tasks["review"], tasks["test"], 1)
+	g.addWeightedEdge(tasks["test"], tasks["release"], 2)
+	g.addWeightedEdge(tasks["docs"], tasks["release"], 4)
+	return g, tasks
+}
+
+func TestLongestPath(t *testing.T) {
+	g, tasks := buildProjectPlan()
+	path, length, err := g.longestPath()
+	assert.NoError(t, err)
+	assert.Equal(t, 10, length)
+	assert.Equal(t, []*Node{tasks["start"], tasks["design"], tasks["build"], tasks["test"], tasks["release"]}, path)
+}
+
+func TestLongestPathEmptyGraph(t *testing.T) {
+	g := &Graph{}
+	path, length, err := g.longestPath()
+	assert.NoError(t, err)
+	assert.Nil(t, path)
+	assert.Equal(t, 0, length)
+}
+
+func TestCriticalPath(t *testing.T) {
+	g, tasks := buildProjectPlan()
+	schedule, err := g.criticalPath()
+	assert.NoError(t, err)
+	assert.Equal(t, 10, schedule.finish)
+
+	// Both read the same durations, so the finish is the longest path
+	_, length, err := g.longestPath()
+	assert.NoError(t, err)
+	assert.Equal(t, length, schedule.finish)
+
+	expected := map[string][3]int{ // earliest, latest, slack
+		"start":   {0, 0, 0},
+		"design":  {0, 0, 0},
+		"build":   {3, 3, 0},
+		"review":  {3, 7, 4},
+		"docs":    {0, 6, 6},
+		"test":    {8, 8, 0},
+		"release": {10, 10, 0},
+	}
+	for name, times := range expected {
+		n := tasks[name]
+		assert.Equal(t, times[0], schedule.earliestStart[n], name)
+		assert.Equal(t, times[1], schedule.latestStart[n], name)
+		assert.Equal(t, times[2], schedule.slack(n), name)
+	}
+
+	assert.Equal(t, []*Node{tasks["start"], tasks["design"], tasks["build"], tasks["test"], tasks["release"]}, schedule.criticalPath)
+	for _, n := range schedule.criticalPath {
+		assert.Equal(t, 0, schedule.slack(n))
+	}
+}
+
+func TestCriticalPathIndependentTasks(t *testing.T) {
+	g := &Graph{}
+	node1 := g.addNode(1)
+	node2 := g.addNode(2)
+	done := g.addNode(3)
+	g.addWeightedEdge(node1, done, 2)
+	g.addWeightedEdge(node2, done, 5)
+
+	schedule, err := g.criticalPath()
+	assert.NoError(t, err)
+	assert.Equal(t, 5, schedule.finish)
+	assert.Equal(t, 3, schedule.slack(node1))
+	assert.Equal(t, 0, schedule.slack(node2))
+	assert.Equal(t, []*Node{node2, done}, schedule.criticalPath)
+}
+
+func TestCriticalPathSingleTask(t *testing.T) {
+	g := &Graph{}
+	task := g.addNode(1)
+	done := g.addNode(2)
+	g.addWeightedEdge(task, done, 5)
+
+	schedule, err := g.criticalPath()
+	assert.NoError(t, err)
+	assert.Equal(t, 5, schedule.finish)
+	assert.Equal(t, 0, schedule.latestStart[task])
+	assert.Equal(t, 5, schedule.earliestStart[done])
+	assert.Equal(t, []*Node{task, done}, schedule.criticalPath)
+}
+
+func TestCriticalPathLastTaskIsMilestone(t *testing.T) {
+	// Without an edge to a finish milestone a task's duration cannot be known,
+	// so a task with no outgoing edges takes no time
+	g := &Graph{}
+	task := g.addNode(1)
+
+	schedule, err := g.criticalPath()
+	assert.NoError(t, err)
+	assert.Equal(t, 0, schedule.finish)
+	assert.Equal(t, []*Node{task}, schedule.criticalPath)
+}
+
+func TestCriticalPathConflictingDurations(t *testing.T) {
+	g := &Graph{}
+	node1 := g.addNode(1)
+	node2 := g.addNode(2)
+	node3 := g.addNode(3)
+	g.addWeightedEdge(node1, node2, 7)
+	g.addWeightedEdge(node1, node3, 1)
+
+	schedule, err := g.criticalPath()
+	assert.Nil(t, schedule)
+	assert.EqualError(t, err, "critical path: task 1 has conflicting durations 7 and 1")
+}
+
+func TestCriticalPathNegativeDuration(t *testing.T) {
+	g := &Graph{}
+	node1 := g.addNode(1)
+	node2 := g.addNode(2)
+	g.addWeightedEdge(node1, node2, -1)
+
+	schedule, err := g.criticalPath()
+	assert.Nil(t, schedule)
+	assert.EqualError(t, err, "critical path: task 1 has negative duration -1")
+}
+
+func TestCriticalPathEmptyGraph(t *testing.T) {
+	schedule, err := (&Graph{}).criticalPath()
+	assert.NoError(t, err)
+	assert.Equal(t, 0, schedule.finish)
+	assert.Nil(t, schedule.criticalPath)
+}
+
+func TestCriticalPathWithCycle(t *testing.T) {
+	g := &Graph{}
+	node1 := g.addNode(1)
+	node2 := g.addNode(2)
+	node3 := g.addNode(3)
+	g.addWeightedEdge(node1, node2, 2)
+	g.addWeightedEdge(node2, node3, 2)
+	g.addWeightedEdge(node3, node2, 2)
+
+	schedule, err := g.criticalPath()
+	assert.Nil(t, schedule)
+	var cycleErr *CycleError
+	assert.True(t, errors.As(err, &cycleErr))
+	assert.Contains(t, err.Error(), "critical path needs a DAG: graph contains a cycle")
+
+	_, _, err = g.longestPath()
+	assert.True(t, errors.As(err, &cycleErr))
+}
@@ -0,0 +1,59 @@
+package graphs
+
+// CSRGraph is an immutable graph in compressed sparse row form. The edges of node
+// i are targets[offsets[i]:offsets[i+1]] with matching weights, so a whole graph is
+// four flat slices: no per-node allocations for the GC to trace and neighbours that
+// sit next to each other in memory.
+type CSRGraph struct {
+	values  []int
+	offsets []int
+	targets []int32
+	weights []int
+}
+
+// freeze copies g into a CSRGraph. Node i of the result is g.nodes[i], and later
+// changes to g do not affect it.
+//
+// Time Complexity: O(V+E)
+// Space Complexity: O(V+E)
+func (g *Graph) freeze() *CSRGraph {
+	index := g.nodeIndex()
+	c := &CSRGraph{
+		values:  make([]int, len(g.nodes)),
+		offsets: make([]int, len(g.nodes)+1),
+	}
+	edgeCount := 0
+	for _, n := range g.nodes {
+		edgeCount += len(n.adjacent)
+	}
+	c.targets = make([]int32, 0, edgeCount)
+	c.weights = make([]int, 0, edgeCount)
+
+	for i, n := range g.nodes {
+		c.values[i] = n.value
+		for _, adj := range n.adjacent {
+			j, ok := index[adj]
+			if !ok {
+				continue // edge to a node that is not in g
+			}
+			c.targets = append(c.targets, int32(j))
+			c.weights = append(c.weights, n.edges[adj])
+		}
+		c.offsets[i+1] = len(c.targets)
+	}
+	return c
+}
+
+func (c *CSRGraph) order() int { return len(c.values) }
+
+func (c *CSRGraph) value(i int) int { return c.values[i] }
+
+// neighbours returns the targets of node i without copying
+func (c *CSRGraph) neighbours(i int) []int32 {
+	return c.targets[c.offsets[i]:c.offsets[i+1]]
+}
+
+// edgesFrom slices straight into the shared arrays, so it never allocates
+func (c *CSRGraph) edgesFrom(i int) ([]int32, []int) {
+	return c.targets[c.offsets[i]:c.offsets[i+1]], c.weights[c.offsets[i]:c.offsets[i+1]]
+}
@@ -0,0 +1,173 @@
+package graphs
+
+import (
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFreeze(t *testing.T) {
+	g := &Graph{}
+	node1 := g.addNode(10)
+	node2 := g.addNode(20)
+	node3 := g.addNode(30)
+	g.addWeightedEdge(node1, node2, 4)
+	g.addWeightedEdge(node1, node3, 7)
+	g.addWeightedEdge(node3, node1, 1)
+
+	csr := g.freeze()
+	assert.Equal(t, 3, csr.order())
+	assert.Equal(t, 20, csr.value(1))
+	assert.Equal(t, []int32{1, 2}, csr.neighbours(0))
+	assert.Empty(t, csr.neighbours(1))
+	assert.Equal(t, []int32{0}, csr.neighbours(2))
+
+	targets, weights := csr.edgesFrom(0)
+	assert.Equal(t, []int32{1, 2}, targets)
+	assert.Equal(t, []int{4, 7}, weights)
+
+	// The frozen copy does not see later changes
+	g.addEdge(node2, node3)
+	assert.Empty(t, csr.neighbours(1))
+}
+
+func TestViewsSkipRemovedNodes(t *testing.T) {
+	g := &Graph{}
+	node1 := g.addNode(1)
+	node2 := g.addNode(2)
+	node3 := g.addNode(3)
+	g.removeNode(node3)
+	g.addEdge(node2, node3) // dangling edge to a removed node
+
+	// The dangling edge must not be read as an edge to node 0
+	assert.Equal(t, []int{-1, 0}, g.bfsDistances(node2))
+	assert.Equal(t, []int{-1, 0}, bfsLevels(g.freeze(), 1))
+
+	g.addWeightedEdge(node2, node1, 4)
+	for _, v := range []GraphView{g.view(), g.freeze()} {
+		assert.Equal(t, 2, v.order())
+		targets, weights := v.edgesFrom(1)
+		assert.Equal(t, []int32{0}, targets)
+		assert.Equal(t, []int{4}, weights)
+		assert.Equal(t, []int{1, 0}, bfsLevels(v, 1))
+		assert.False(t, viewDFS(v, 0, 1))
+	}
+}
+
+func TestFreezeEmptyGraph(t *testing.T) {
+	csr := (&Graph{}).freeze()
+	assert.Equal(t, 0, csr.order())
+}
+
+func TestViewSearchesAgreeAcrossRepresentations(t *testing.T) {
+	g := &Graph{}
+	node1 := g.addNode(1)
+	node2 := g.addNode(2)
+	node3 := g.addNode(3)
+	node4 := g.addNode(4)
+	node5 := g.addNode(5)
+	g.addWeightedEdge(node1, node2, 1)
+	g.addWeightedEdge(node1, node3, 4)
+	g.addWeightedEdge(node2, node3, 2)
+	g.addWeightedEdge(node2, node4, 5)
+	g.addWeightedEdge(node3, node4, 1)
+	g.addWeightedEdge(node5, node1, 1)
+
+	for _, v := range []GraphView{g.view(), g.freeze()} {
+		path, dist := viewDijkstra(v, 0, 3)
+		assert.Equal(t, 4, dist)
+		assert.Equal(t, []int{0, 1, 2, 3}, path)
+
+		path, dist = viewDijkstra(v, 0, 4)
+		assert.Nil(t, path)
+		assert.Equal(t, -1, dist)
+
+		assert.True(t, viewDFS(v, 4, 3))
+		assert.False(t, viewDFS(v, 3, 0))
+		assert.Equal(t, []int{0, 1, 1, 2, -1}, bfsLevels(v, 0))
+	}
+}
+
+func TestViewSearchesOnRandomGraph(t *testing.T) {
+	g := randomGraph(2000, 3, 7)
+	for i, n := range g.nodes {
+		g.addWeightedEdge(n, g.nodes[(i*7+3)%len(g.nodes)], i%5) // vary the weights
+	}
+	csr := g.freeze()
+
+	assert.Equal(t, g.bfsDistances(g.nodes[0]), bfsLevels(csr, 0))
+	for _, target := range []int{1, 10, 500, 1999} {
+		_, expected := dijkstra(g.nodes[0], g.nodes[target])
+		_, actual := viewDijkstra(csr, 0, target)
+		assert.Equal(t, expected, actual)
+		assert.Equal(t, depthFirstSearch(g.nodes[0], g.nodes[target]), viewDFS(csr, 0, target))
+	}
+}
+
+// heapInUse forces a collection and reports the live heap size
+func heapInUse() uint64 {
+	runtime.GC()
+	var stats runtime.MemStats
+	runtime.ReadMemStats(&stats)
+	return stats.HeapAlloc
+}
+
+// Reports the live memory needed to hold each representation of the same graph
+func BenchmarkGraphMemory(b *testing.B) {
+	for b.Loop() {
+		before := heapInUse()
+		g := randomGraph(100_000, 8, 1)
+		afterGraph := heapInUse()
+		csr := g.freeze()
+		afterCSR := heapInUse()
+		b.ReportMetric(float64(afterGraph-before)/float64(800_000), "graph-B/edge")
+		b.ReportMetric(float64(afterCSR-afterGraph)/float64(800_000), "csr-B/edge")
+		runtime.KeepAlive(g)
+		runtime.KeepAlive(csr)
+	}
+}
+
+func BenchmarkBFSGraph(b *testing.B) {
+	g := randomGraph(200_000, 8, 1)
+	for b.Loop() {
+		g.bfsDistances(g.nodes[0])
+	}
+}
+
+func BenchmarkBFSCSR(b *testing.B) {
+	csr := randomGraph(200_000, 8, 1).freeze()
+	for b.Loop() {
+		bfsLevels(csr, 0)
+	}
+}
+
+func BenchmarkDFSGraph(b *testing.B) {
+	g := randomGraph(200_000, 8, 1)
+	target := &Node{} // not in the graph, so the whole reachable set is explored
+	for b.Loop() {
+		depthFirstSearch(g.nodes[0], target)
+	}
+}
+
+func BenchmarkDFSCSR(b *testing.B) {
+	csr := randomGraph(200_000, 8, 1).freeze()
+	for b.Loop() {
+		viewDFS(csr, 0, -1)
+	}
+}
+
+func BenchmarkDijkstraGraph(b *testing.B) {
+	g := randomGraph(200_000, 8, 1)
+	target := &Node{}
+	for b.Loop() {
+		dijkstra(g.nodes[0], target)
+	}
+}
+
+func BenchmarkDijkstraCSR(b *testing.B) {
+	csr := randomGraph(200_000, 8, 1).freeze()
+	for b.Loop() {
+		viewDijkstra(csr, 0, -1)
+	}
+}
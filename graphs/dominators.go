@@ -0,0 +1,191 @@
+package graphs
+
+// DominatorTree records, for every node reachable from the entry, its immediate
+// dominator: the closest node that lies on every path from the entry to it.
+// Nodes unreachable from the entry are not part of the tree.
+type DominatorTree struct {
+	entry    *Node
+	idom     map[*Node]*Node
+	children map[*Node][]*Node
+	order    []*Node // reverse postorder from the entry
+	preds    map[*Node][]*Node
+}
+
+// Cooper, Harvey & Kennedy "A Simple, Fast Dominance Algorithm".
+// Nodes are numbered in reverse postorder and the idom of each node is refined to
+// the common ancestor of its processed predecessors until nothing changes. In
+// practice this converges in two or three passes over a control-flow graph.
+//
+// Time Complexity: O(V+E) per pass, O(V*(V+E)) in the worst case
+// Space Complexity: O(V+E)
+func (g *Graph) dominators(entry *Node) *DominatorTree {
+	succs := g.successors()
+	return computeDominators(entry, func(n *Node) []*Node { return succs[n] }, g.predecessors())
+}
+
+// Post-dominators are the dominators of the reversed graph rooted at exit: a node
+// b post-dominates a when every path from a to the exit passes through b.
+func (g *Graph) postDominators(exit *Node) *DominatorTree {
+	preds := g.predecessors()
+	return computeDominators(exit, func(n *Node) []*Node { return preds[n] }, g.successors())
+}
+
+// successors is the adjacency of every node with edges to nodes that are not in
+// g left out, so walks cannot leave the graph
+func (g *Graph) successors() map[*Node][]*Node {
+	index := g.nodeIndex()
+	succs := make(map[*Node][]*Node, len(g.nodes))
+	for _, n := range g.nodes {
+		for _, adj := range n.adjacent {
+			if _, ok := index[adj]; ok {
+				succs[n] = append(succs[n], adj)
+			}
+		}
+	}
+	return succs
+}
+
+// predecessors inverts the adjacency lists so algorithms can walk edges backwards.
+// Edges to nodes that are not in g are left out.
+func (g *Graph) predecessors() map[*Node][]*Node {
+	index := g.nodeIndex()
+	preds := make(map[*Node][]*Node, len(g.nodes))
+	for _, n := range g.nodes {
+		for _, adj := range n.adjacent {
+			if _, ok := index[adj]; ok {
+				preds[adj] = append(preds[adj], n)
+			}
+		}
+	}
+	return preds
+}
+
+func computeDominators(entry *Node, successors func(*Node) []*Node, preds map[*Node][]*Node) *DominatorTree {
+	// Number nodes in postorder; reverse postorder visits a node before its
+	// successors except along back edges.
+	postorder := make(map[*Node]int)
+	var order []*Node
+	var dfs func(n *Node)
+	dfs = func(n *Node) {
+		postorder[n] = -1 // mark as visited while on the stack
+		for _, adj := range successors(n) {
+			if _, seen := postorder[adj]; !seen {
+				dfs(adj)
+			}
+		}
+		postorder[n] = len(order)
+		order = append(order, n)
+	}
+	dfs(entry)
+	for i, j := 0, len(order)-1; i < j; i, j = i+1, j-1 {
+		order[i], order[j] = order[j], order[i]
+	}
+
+	idom := map[*Node]*Node{entry: entry}
+	intersect := func(a, b *Node) *Node {
+		for a != b {
+			for postorder[a] < postorder[b] {
+				a = idom[a]
+			}
+			for postorder[b] < postorder[a] {
+				b = idom[b]
+			}
+		}
+		return a
+	}
+
+	for changed := true; changed; {
+		changed = false
+		for _, n := range order[1:] {
+			var newIdom *Node
+			for _, p := range preds[n] {
+				if _, processed := idom[p]; !processed {
+					continue
+				}
+				if newIdom == nil {
+					newIdom = p
+				} else {
+					newIdom = intersect(p, newIdom)
+				}
+			}
+			if idom[n] != newIdom {
+				idom[n] = newIdom
+				changed = true
+			}
+		}
+	}
+
+	children := make(map[*Node][]*Node)
+	for _, n := range order[1:] {
+		children[idom[n]] = append(children[idom[n]], n)
+	}
+	return &DominatorTree{entry: entry, idom: idom, children: children, order: order, preds: preds}
+}
+
+// immediateDominator returns nil for the entry node and for unreachable nodes
+func (t *DominatorTree) immediateDominator(n *Node) *Node {
+	if n == t.entry {
+		return nil
+	}
+	return t.idom[n]
+}
+
+// dominates reports whether a dominates b; every reachable node dominates itself
+func (t *DominatorTree) dominates(a, b *Node) bool {
+	if _, ok := t.idom[b]; !ok {
+		return false
+	}
+	for b != t.entry {
+		if b == a {
+			return true
+		}
+		b = t.idom[b]
+	}
+	return a == t.entry
+}
+
+// Dominance frontier - DF(a) holds the nodes b where a dominates a predecessor of
+// b but does not strictly dominate b, i.e. where a's dominance ends. These are the
+// places SSA construction inserts phi functions. Only join points (two or more
+// predecessors) can be in a frontier, so we walk up from each of their predecessors
+// until we reach the join point's immediate dominator. The entry counts as a join
+// point as soon as it has any predecessor, since it also has an implicit one from
+// the virtual start; that start is its immediate dominator, so walks to it run all
+// the way up to and including the entry.
+//
+// Time Complexity: O(E + size of the frontiers)
+// Space Complexity: O(size of the frontiers)
+func (t *DominatorTree) dominanceFrontier() map[*Node][]*Node {
+	frontier := make(map[*Node][]*Node)
+	for _, b := range t.order {
+		stop := t.idom[b]
+		if b == t.entry {
+			stop = nil // the virtual start, above the entry
+		} else if len(t.preds[b]) < 2 {
+			continue
+		}
+		for _, p := range t.preds[b] {
+			if _, reachable := t.idom[p]; !reachable {
+				continue
+			}
+			for runner := p; runner != stop; runner = t.idom[runner] {
+				if !containsNode(frontier[runner], b) {
+					frontier[runner] = append(frontier[runner], b)
+				}
+				if runner == t.entry {
+					break
+				}
+			}
+		}
+	}
+	return frontier
+}
+
+func containsNode(nodes []*Node, target *Node) bool {
+	for _, n := range nodes {
+		if n == target {
+			return true
+		}
+	}
+	return false
+}
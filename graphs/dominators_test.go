@@ -0,0 +1,169 @@
+package graphs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// Control-flow graph for an if/else inside a loop:
+//
+//	1 -> 2 -> {3, 4} -> 5 -> 6 -> 7
+//	          ^                |
+//	          +----------------+
+func buildLoopCFG() (*Graph, []*Node) {
+	g := &Graph{}
+	nodes := []*Node{nil}
+	for i := 1; i <= 7; i++ {
+		nodes = append(nodes, g.addNode(i))
+	}
+	g.addEdge(nodes[1], nodes[2])
+	g.addEdge(nodes[2], nodes[3])
+	g.addEdge(nodes[2], nodes[4])
+	g.addEdge(nodes[3], nodes[5])
+	g.addEdge(nodes[4], nodes[5])
+	g.addEdge(nodes[5], nodes[6])
+	g.addEdge(nodes[6], nodes[2]) // back edge
+	g.addEdge(nodes[6], nodes[7])
+	return g, nodes
+}
+
+func nodeValues(nodes []*Node) []int {
+	vals := make([]int, len(nodes))
+	for i, n := range nodes {
+		vals[i] = n.value
+	}
+	return vals
+}
+
+func TestDominators(t *testing.T) {
+	g, n := buildLoopCFG()
+	tree := g.dominators(n[1])
+
+	assert.Nil(t, tree.immediateDominator(n[1]))
+	assert.Equal(t, n[1], tree.immediateDominator(n[2]))
+	assert.Equal(t, n[2], tree.immediateDominator(n[3]))
+	assert.Equal(t, n[2], tree.immediateDominator(n[4]))
+	assert.Equal(t, n[2], tree.immediateDominator(n[5]))
+	assert.Equal(t, n[5], tree.immediateDominator(n[6]))
+	assert.Equal(t, n[6], tree.immediateDominator(n[7]))
+
+	assert.ElementsMatch(t, []int{3, 4, 5}, nodeValues(tree.children[n[2]]))
+
+	assert.True(t, tree.dominates(n[1], n[7]))
+	assert.True(t, tree.dominates(n[5], n[5]))
+	assert.True(t, tree.dominates(n[2], n[6]))
+	assert.False(t, tree.dominates(n[3], n[5]))
+	assert.False(t, tree.dominates(n[7], n[6]))
+}
+
+func TestDominatorsUnreachableNode(t *testing.T) {
+	g, n := buildLoopCFG()
+	orphan := g.addNode(8)
+	g.addEdge(orphan, n[5]) // an extra predecessor nobody can reach
+
+	tree := g.dominators(n[1])
+	assert.Nil(t, tree.immediateDominator(orphan))
+	assert.False(t, tree.dominates(n[1], orphan))
+	assert.Equal(t, n[2], tree.immediateDominator(n[5]))
+}
+
+func TestDominanceFrontier(t *testing.T) {
+	g, n := buildLoopCFG()
+	frontier := g.dominators(n[1]).dominanceFrontier()
+
+	assert.Empty(t, frontier[n[1]])
+	assert.Equal(t, []int{2}, nodeValues(frontier[n[2]]))
+	assert.Equal(t, []int{5}, nodeValues(frontier[n[3]]))
+	assert.Equal(t, []int{5}, nodeValues(frontier[n[4]]))
+	assert.Equal(t, []int{2}, nodeValues(frontier[n[5]]))
+	assert.Equal(t, []int{2}, nodeValues(frontier[n[6]]))
+	assert.Empty(t, frontier[n[7]])
+}
+
+func TestDominanceFrontierBackEdgeToEntry(t *testing.T) {
+	g := &Graph{}
+	node0 := g.addNode(0)
+	node1 := g.addNode(1)
+	g.addEdge(node0, node1)
+	g.addEdge(node1, node0) // back edge to the entry
+
+	frontier := g.dominators(node0).dominanceFrontier()
+	assert.Equal(t, []int{0}, nodeValues(frontier[node0]))
+	assert.Equal(t, []int{0}, nodeValues(frontier[node1]))
+
+	// A back edge from the exit to the entry puts the entry in the frontier of
+	// every node on the dominator tree path from the exit
+	g, n := buildLoopCFG()
+	g.addEdge(n[7], n[1])
+	frontier = g.dominators(n[1]).dominanceFrontier()
+	assert.Equal(t, []int{1}, nodeValues(frontier[n[1]]))
+	assert.ElementsMatch(t, []int{2, 1}, nodeValues(frontier[n[2]]))
+	assert.ElementsMatch(t, []int{2, 1}, nodeValues(frontier[n[6]]))
+	assert.Equal(t, []int{1}, nodeValues(frontier[n[7]]))
+	assert.Equal(t, []int{5}, nodeValues(frontier[n[3]]))
+}
+
+func TestDominanceFrontierMatchesDefinition(t *testing.T) {
+	for seed := int64(0); seed < 50; seed++ {
+		g := randomGraph(12, 2, seed)
+		tree := g.dominators(g.nodes[0])
+		frontier := tree.dominanceFrontier()
+		preds := g.predecessors()
+		for _, a := range tree.order {
+			var expected []int
+			for _, b := range tree.order {
+				strictlyDominates := a != b && tree.dominates(a, b)
+				for _, p := range preds[b] {
+					if tree.dominates(a, p) && !strictlyDominates {
+						expected = append(expected, b.value)
+						break
+					}
+				}
+			}
+			assert.ElementsMatch(t, expected, nodeValues(frontier[a]), "seed %d node %d", seed, a.value)
+		}
+	}
+}
+
+func TestDominatorsSkipDanglingEdges(t *testing.T) {
+	g, n := buildLoopCFG()
+	removed := g.addNode(8)
+	g.removeNode(removed)
+	g.addEdge(n[3], removed) // dangling edge out of one branch
+
+	tree := g.dominators(n[1])
+	assert.Equal(t, 7, len(tree.order))
+	assert.Nil(t, tree.immediateDominator(removed))
+	assert.False(t, tree.dominates(n[3], removed))
+	assert.Equal(t, []int{5}, nodeValues(tree.dominanceFrontier()[n[3]]))
+
+	post := g.postDominators(n[7])
+	assert.Equal(t, n[5], post.immediateDominator(n[3]))
+}
+
+func TestPostDominators(t *testing.T) {
+	g, n := buildLoopCFG()
+	tree := g.postDominators(n[7])
+
+	assert.Nil(t, tree.immediateDominator(n[7]))
+	assert.Equal(t, n[7], tree.immediateDominator(n[6]))
+	assert.Equal(t, n[6], tree.immediateDominator(n[5]))
+	assert.Equal(t, n[5], tree.immediateDominator(n[3]))
+	assert.Equal(t, n[5], tree.immediateDominator(n[4]))
+	assert.Equal(t, n[5], tree.immediateDominator(n[2]))
+	assert.Equal(t, n[2], tree.immediateDominator(n[1]))
+
+	assert.True(t, tree.dominates(n[5], n[3]))
+	assert.False(t, tree.dominates(n[3], n[2]))
+}
+
+func TestDominatorsSingleNode(t *testing.T) {
+	g := &Graph{}
+	node1 := g.addNode(1)
+
+	tree := g.dominators(node1)
+	assert.Nil(t, tree.immediateDominator(node1))
+	assert.True(t, tree.dominates(node1, node1))
+	assert.Empty(t, tree.dominanceFrontier())
+}
@@ -0,0 +1,215 @@
+package graphs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// Task is the unit of work attached to a node of an Executor's graph
+type Task func(ctx context.Context) error
+
+// FailurePolicy decides what the Executor does after a task fails
+type FailurePolicy int
+
+const (
+	// FailFast cancels running tasks and starts no new ones after the first failure
+	FailFast FailurePolicy = iota
+	// ContinueOnError keeps running every task whose dependencies all succeeded
+	ContinueOnError
+)
+
+type TaskStatus int
+
+const (
+	TaskSucceeded TaskStatus = iota + 1
+	TaskFailed
+	TaskSkipped   // a dependency did not succeed so the task never ran
+	TaskCancelled // the run was cancelled before or while the task ran
+)
+
+func (s TaskStatus) String() string {
+	switch s {
+	case TaskSucceeded:
+		return "succeeded"
+	case TaskFailed:
+		return "failed"
+	case TaskSkipped:
+		return "skipped"
+	case TaskCancelled:
+		return "cancelled"
+	}
+	return fmt.Sprintf("TaskStatus(%d)", int(s))
+}
+
+// TaskResult reports what happened to one node. Started and Finished are zero
+// for tasks that never ran.
+type TaskResult struct {
+	Status   TaskStatus
+	Err      error
+	Started  time.Time
+	Finished time.Time
+}
+
+// Executor runs a task per node of a DAG where an edge u -> v means v depends on
+// u. Each task starts as soon as all of its predecessors have succeeded, with at
+// most 'workers' tasks running at once. Nodes without a task succeed immediately.
+type Executor struct {
+	graph   *Graph
+	tasks   map[*Node]Task
+	workers int
+	policy  FailurePolicy
+}
+
+func newExecutor(g *Graph, workers int, policy FailurePolicy) *Executor {
+	if workers < 1 {
+		workers = 1
+	}
+	return &Executor{graph: g, tasks: make(map[*Node]Task), workers: workers, policy: policy}
+}
+
+func (e *Executor) setTask(n *Node, task Task) {
+	e.tasks[n] = task
+}
+
+type taskOutcome struct {
+	node   *Node
+	result TaskResult
+}
+
+// run executes the graph and returns a result for every node. The error joins the
+// errors of all failed tasks, or is ctx.Err() if the caller cancelled the run, or
+// a *CycleError (wrapped) if the dependencies cannot be ordered.
+//
+// A coordinator goroutine (the caller) owns all bookkeeping: it counts how many
+// predecessors of each node are still outstanding and hands a node to the worker
+// pool when that count reaches zero. Workers only run tasks and send back outcomes.
+func (e *Executor) run(ctx context.Context) (map[*Node]TaskResult, error) {
+	order, err := e.graph.topologicalSort()
+	if err != nil {
+		return nil, fmt.Errorf("executor needs a DAG: %w", err)
+	}
+
+	runCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	jobs := make(chan *Node, len(order))
+	outcomes := make(chan taskOutcome, len(order))
+	var wg sync.WaitGroup
+	for i := 0; i < e.workers; i++ {
+		wg.Go(func() {
+			for n := range jobs {
+				outcomes <- taskOutcome{node: n, result: e.runTask(runCtx, n)}
+			}
+		})
+	}
+
+	// Only edges between nodes of the graph count: a dangling edge to a removed
+	// node must not dispatch it, or more jobs than len(order) would be sent
+	index := e.graph.nodeIndex()
+	outstanding := make(map[*Node]int, len(order))
+	for _, n := range order {
+		for _, adj := range n.adjacent {
+			if _, ok := index[adj]; ok {
+				outstanding[adj]++
+			}
+		}
+	}
+
+	results := make(map[*Node]TaskResult, len(order))
+	pending := 0
+	dispatch := func(n *Node) {
+		results[n] = TaskResult{} // marks the node as dispatched
+		pending++
+		jobs <- n
+	}
+	for _, n := range order {
+		if outstanding[n] == 0 {
+			dispatch(n)
+		}
+	}
+
+	var errs []error
+	for pending > 0 {
+		outcome := <-outcomes
+		pending--
+		results[outcome.node] = outcome.result
+
+		switch outcome.result.Status {
+		case TaskSucceeded:
+			for _, adj := range outcome.node.adjacent {
+				if _, ok := index[adj]; !ok {
+					continue // edge to a node that is not in g
+				}
+				outstanding[adj]--
+				if outstanding[adj] == 0 && runCtx.Err() == nil {
+					dispatch(adj)
+				}
+			}
+		case TaskFailed:
+			errs = append(errs, fmt.Errorf("task %d: %w", outcome.node.value, outcome.result.Err))
+			if e.policy == FailFast {
+				cancel()
+			}
+		}
+	}
+	close(jobs)
+	wg.Wait()
+
+	// Nodes never handed to a worker were either blocked by a dependency that did
+	// not succeed, or still waiting when the run was cancelled
+	for _, n := range order {
+		if _, dispatched := results[n]; dispatched {
+			continue
+		}
+		results[n] = TaskResult{Status: TaskCancelled}
+	}
+	predecessors := e.graph.predecessors()
+	for _, n := range order {
+		if results[n].Started.IsZero() && results[n].Status == TaskCancelled {
+			for _, p := range predecessors[n] {
+				if s := results[p].Status; s == TaskFailed || s == TaskSkipped {
+					results[n] = TaskResult{Status: TaskSkipped}
+					break
+				}
+			}
+		}
+	}
+
+	if len(errs) > 0 {
+		return results, errors.Join(errs...)
+	}
+	return results, ctx.Err()
+}
+
+// runTask runs one node's task, converting panics into failures. A task that
+// errors after the run was cancelled is reported as cancelled rather than failed.
+func (e *Executor) runTask(ctx context.Context, n *Node) (result TaskResult) {
+	if ctx.Err() != nil {
+		return TaskResult{Status: TaskCancelled, Err: ctx.Err()}
+	}
+	task := e.tasks[n]
+	result.Started = time.Now()
+	defer func() {
+		if r := recover(); r != nil {
+			result.Status = TaskFailed
+			result.Err = fmt.Errorf("task panicked: %v", r)
+		}
+		result.Finished = time.Now()
+	}()
+
+	if task != nil {
+		result.Err = task(ctx)
+	}
+	switch {
+	case result.Err == nil:
+		result.Status = TaskSucceeded
+	case ctx.Err() != nil:
+		result.Status = TaskCancelled
+	default:
+		result.Status = TaskFailed
+	}
+	return result
+}
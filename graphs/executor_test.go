@@ -0,0 +1,214 @@
+package graphs
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// recorder is a fake task factory that logs the order in which tasks finish
+type recorder struct {
+	mu       sync.Mutex
+	finished []int
+}
+
+func (r *recorder) task(value int, err error) Task {
+	return func(ctx context.Context) error {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		r.finished = append(r.finished, value)
+		return err
+	}
+}
+
+func TestExecutorRespectsDependencies(t *testing.T) {
+	// Diamond 1 -> {2, 3} -> 4, then 4 -> 5
+	g := buildGraph([]int{1, 2, 3, 4, 5}, [][2]int{{0, 1}, {0, 2}, {1, 3}, {2, 3}, {3, 4}})
+	r := &recorder{}
+	e := newExecutor(g, 4, FailFast)
+	for _, n := range g.nodes {
+		e.setTask(n, r.task(n.value, nil))
+	}
+
+	results, err := e.run(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, 5, len(results))
+	for _, n := range g.nodes {
+		assert.Equal(t, TaskSucceeded, results[n].Status)
+		assert.False(t, results[n].Started.IsZero())
+	}
+
+	position := make(map[int]int)
+	for i, v := range r.finished {
+		position[v] = i
+	}
+	for _, n := range g.nodes {
+		for _, adj := range n.adjacent {
+			assert.Less(t, position[n.value], position[adj.value])
+			assert.False(t, results[adj].Started.Before(results[n].Finished))
+		}
+	}
+}
+
+func TestExecutorBoundsConcurrency(t *testing.T) {
+	g := buildGraph([]int{1, 2, 3, 4, 5, 6, 7, 8}, nil)
+	e := newExecutor(g, 3, FailFast)
+	var running, maxRunning atomic.Int32
+	for _, n := range g.nodes {
+		e.setTask(n, func(ctx context.Context) error {
+			now := running.Add(1)
+			for {
+				seen := maxRunning.Load()
+				if now <= seen || maxRunning.CompareAndSwap(seen, now) {
+					break
+				}
+			}
+			running.Add(-1)
+			return nil
+		})
+	}
+
+	_, err := e.run(context.Background())
+	assert.NoError(t, err)
+	assert.LessOrEqual(t, maxRunning.Load(), int32(3))
+}
+
+func TestExecutorFailFast(t *testing.T) {
+	// 1 fails, 2 depends on 1, 3 is independent and blocks until cancelled
+	g := buildGraph([]int{1, 2, 3}, [][2]int{{0, 1}})
+	e := newExecutor(g, 2, FailFast)
+	boom := errors.New("boom")
+	started := make(chan struct{})
+	e.setTask(g.nodes[0], func(ctx context.Context) error {
+		<-started
+		return boom
+	})
+	e.setTask(g.nodes[1], func(ctx context.Context) error {
+		t.Error("dependent of a failed task must not run")
+		return nil
+	})
+	e.setTask(g.nodes[2], func(ctx context.Context) error {
+		close(started)
+		<-ctx.Done()
+		return ctx.Err()
+	})
+
+	results, err := e.run(context.Background())
+	assert.ErrorIs(t, err, boom)
+	assert.Contains(t, err.Error(), "task 1: boom")
+	assert.Equal(t, TaskFailed, results[g.nodes[0]].Status)
+	assert.Equal(t, TaskSkipped, results[g.nodes[1]].Status)
+	assert.Equal(t, TaskCancelled, results[g.nodes[2]].Status)
+}
+
+func TestExecutorContinueOnError(t *testing.T) {
+	// 1 -> 2 -> 3 with 1 failing, and an independent chain 4 -> 5
+	g := buildGraph([]int{1, 2, 3, 4, 5}, [][2]int{{0, 1}, {1, 2}, {3, 4}})
+	r := &recorder{}
+	e := newExecutor(g, 2, ContinueOnError)
+	for _, n := range g.nodes {
+		e.setTask(n, r.task(n.value, nil))
+	}
+	e.setTask(g.nodes[0], r.task(1, errors.New("broken")))
+
+	results, err := e.run(context.Background())
+	assert.EqualError(t, err, "task 1: broken")
+	assert.Equal(t, TaskFailed, results[g.nodes[0]].Status)
+	assert.Equal(t, TaskSkipped, results[g.nodes[1]].Status)
+	assert.Equal(t, TaskSkipped, results[g.nodes[2]].Status)
+	assert.Equal(t, TaskSucceeded, results[g.nodes[3]].Status)
+	assert.Equal(t, TaskSucceeded, results[g.nodes[4]].Status)
+	assert.ElementsMatch(t, []int{1, 4, 5}, r.finished)
+}
+
+func TestExecutorContextCancelled(t *testing.T) {
+	g := buildGraph([]int{1, 2}, [][2]int{{0, 1}})
+	e := newExecutor(g, 1, ContinueOnError)
+	ctx, cancel := context.WithCancel(context.Background())
+	e.setTask(g.nodes[0], func(context.Context) error {
+		cancel()
+		return nil
+	})
+
+	results, err := e.run(ctx)
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t, TaskSucceeded, results[g.nodes[0]].Status)
+	assert.Equal(t, TaskCancelled, results[g.nodes[1]].Status)
+	assert.True(t, results[g.nodes[1]].Started.IsZero())
+}
+
+func TestExecutorPanickingTask(t *testing.T) {
+	g := buildGraph([]int{1}, nil)
+	e := newExecutor(g, 1, FailFast)
+	e.setTask(g.nodes[0], func(context.Context) error { panic("oops") })
+
+	results, err := e.run(context.Background())
+	assert.EqualError(t, err, "task 1: task panicked: oops")
+	assert.Equal(t, TaskFailed, results[g.nodes[0]].Status)
+}
+
+func TestExecutorNodeWithoutTask(t *testing.T) {
+	g := buildGraph([]int{1, 2}, [][2]int{{0, 1}})
+	r := &recorder{}
+	e := newExecutor(g, 1, FailFast)
+	e.setTask(g.nodes[1], r.task(2, nil))
+
+	results, err := e.run(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, TaskSucceeded, results[g.nodes[0]].Status)
+	assert.Equal(t, []int{2}, r.finished)
+}
+
+func TestExecutorDanglingSuccessors(t *testing.T) {
+	// 1 -> 2 plus five edges from 1 to removed nodes; dispatching those used to
+	// overflow the job queue and deadlock with a single worker
+	g := buildGraph([]int{1, 2}, [][2]int{{0, 1}})
+	for i := range 5 {
+		removed := g.addNode(10 + i)
+		g.removeNode(removed)
+		g.addEdge(g.nodes[0], removed)
+	}
+	r := &recorder{}
+	e := newExecutor(g, 1, FailFast)
+	e.setTask(g.nodes[0], r.task(1, nil))
+	e.setTask(g.nodes[1], r.task(2, nil))
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		results, err := e.run(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, 2, len(results))
+		assert.Equal(t, TaskSucceeded, results[g.nodes[1]].Status)
+		assert.Equal(t, []int{1, 2}, r.finished)
+	}()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("run did not return")
+	}
+
+	// A failure still skips the real successor and nothing else
+	e.setTask(g.nodes[0], func(context.Context) error { return errors.New("boom") })
+	results, err := e.run(context.Background())
+	assert.Error(t, err)
+	assert.Equal(t, 2, len(results))
+	assert.Equal(t, TaskSkipped, results[g.nodes[1]].Status)
+}
+
+func TestExecutorWithCycle(t *testing.T) {
+	g := buildGraph([]int{1, 2}, [][2]int{{0, 1}, {1, 0}})
+	results, err := newExecutor(g, 1, FailFast).run(context.Background())
+	assert.Nil(t, results)
+	assert.IsType(t, &CycleError{}, errors.Unwrap(err))
+}
+
+func TestTaskStatusString(t *testing.T) {
+	assert.Equal(t, "skipped", TaskSkipped.String())
+	assert.Equal(t, "TaskStatus(0)", TaskStatus(0).String())
+}
@@ -0,0 +1,121 @@
+package graphs
+
+import "container/heap"
+
+// GraphView is a read-only graph whose nodes are numbered 0..order()-1. The
+// index-based searches below run on any GraphView, so the same code handles the
+// pointer-based Graph and the compact CSRGraph.
+type GraphView interface {
+	order() int
+	// edgesFrom returns the target indices and weights of the edges leaving node i.
+	// Callers must not modify the returned slices.
+	edgesFrom(i int) ([]int32, []int)
+}
+
+// pointerView presents a Graph as a GraphView, numbering nodes by their position
+// in g.nodes. Every call allocates and every edge costs a map lookup to turn the
+// *Node into an index, which is the overhead CSRGraph avoids.
+type pointerView struct {
+	g     *Graph
+	index map[*Node]int
+}
+
+func (g *Graph) view() *pointerView {
+	return &pointerView{g: g, index: g.nodeIndex()}
+}
+
+func (v *pointerView) order() int { return len(v.g.nodes) }
+
+func (v *pointerView) edgesFrom(i int) ([]int32, []int) {
+	n := v.g.nodes[i]
+	targets := make([]int32, 0, len(n.adjacent))
+	weights := make([]int, 0, len(n.adjacent))
+	for _, adj := range n.adjacent {
+		j, ok := v.index[adj]
+		if !ok {
+			continue // edge to a node that is not in g, skipped as freeze does
+		}
+		targets = append(targets, int32(j))
+		weights = append(weights, n.edges[adj])
+	}
+	return targets, weights
+}
+
+// Iterative depth first search on a GraphView - an explicit stack instead of
+// recursion so very deep graphs cannot overflow the goroutine stack
+//
+// Time Complexity: O(V+E)
+// Space Complexity: O(V)
+func viewDFS(v GraphView, start, target int) bool {
+	visited := newBitset(v.order())
+	stack := []int{start}
+	visited.set(start)
+	for len(stack) > 0 {
+		i := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		if i == target {
+			return true
+		}
+		targets, _ := v.edgesFrom(i)
+		for _, j := range targets {
+			if !visited.has(int(j)) {
+				visited.set(int(j))
+				stack = append(stack, int(j))
+			}
+		}
+	}
+	return false
+}
+
+// Dijkstra's algorithm on a GraphView - the same algorithm as dijkstra but with
+// slices indexed by node number in place of maps keyed by *Node
+//
+// Time Complexity: O(E log E)
+// Space Complexity: O(V+E)
+func viewDijkstra(v GraphView, start, target int) ([]int, int) {
+	distances := unreachableDistances(v.order())
+	previous := make([]int, v.order())
+	visited := newBitset(v.order())
+	distances[start] = 0
+	previous[start] = -1
+
+	pq := make(PriorityQueue[int], 0)
+	heap.Push(&pq, &Item[int]{state: start, distance: 0, priority: 0})
+
+	for pq.Len() > 0 {
+		current := heap.Pop(&pq).(*Item[int])
+		i := current.state
+		if visited.has(i) {
+			continue
+		}
+		visited.set(i)
+
+		if i == target {
+			var path []int
+			for n := target; n != -1; n = previous[n] {
+				path = append(path, n)
+			}
+			for a, b := 0, len(path)-1; a < b; a, b = a+1, b-1 {
+				path[a], path[b] = path[b], path[a]
+			}
+			return path, current.distance
+		}
+
+		targets, weights := v.edgesFrom(i)
+		for k, t := range targets {
+			j := int(t)
+			if visited.has(j) {
+				continue
+			}
+			newDist := current.distance + weights[k]
+			if distances[j] == -1 || newDist < distances[j] {
+				distances[j] = newDist
+				previous[j] = i
+				heap.Push(&pq, &Item[int]{state: j, distance: newDist, priority: newDist})
+			}
+		}
+	}
+
+	// Target not reachable
+	return nil, -1
+}
@@ -1,9 +1,7 @@
 package graphs
 
 import (
-	"container/heap"
 	"fmt"
-	"math"
 	"strings"
 )
 
@@ -89,53 +87,49 @@ func depthFirstSearch(start, target *Node) bool {
 }
 
 func breadthFirstSearch(start, target *Node) bool {
-	visited := make(map[*Node]bool)
-	queue := []*Node{start}
-	for len(queue) > 0 {
-		node := queue[0]
-		queue = queue[1:]
-		if node == target {
-			return true
-		}
-		visited[node] = true
-		for _, adj := range node.adjacent {
-			if !visited[adj] {
-				queue = append(queue, adj)
-				visited[adj] = true
-			}
-		}
+	_, found := implicitBFS(start, (*Node).outEdges, func(n *Node) bool { return n == target })
+	return found
+}
+
+// outEdges lists the weighted edges leaving n so the explicit graph can be searched
+// with the same code as implicit graphs
+func (n *Node) outEdges() []Edge[*Node] {
+	edges := make([]Edge[*Node], len(n.adjacent))
+	for i, adj := range n.adjacent {
+		edges[i] = Edge[*Node]{To: adj, Weight: n.edges[adj]}
 	}
-	return false
+	return edges
 }
 
-// Priority queue item for Dijkstra's algorithm
-type Item struct {
-	node     *Node
-	distance int
+// Priority queue item for Dijkstra's algorithm and A*
+type Item[S comparable] struct {
+	state    S
+	distance int // cost of the best known path from the start
+	priority int // distance plus any heuristic estimate to the goal
 	index    int
 }
 
-type PriorityQueue []*Item
+type PriorityQueue[S comparable] []*Item[S]
 
-func (pq PriorityQueue) Len() int { return len(pq) }
+func (pq PriorityQueue[S]) Len() int { return len(pq) }
 
-func (pq PriorityQueue) Less(i, j int) bool {
-	return pq[i].distance < pq[j].distance
+func (pq PriorityQueue[S]) Less(i, j int) bool {
+	return pq[i].priority < pq[j].priority
 }
 
-func (pq PriorityQueue) Swap(i, j int) {
+func (pq PriorityQueue[S]) Swap(i, j int) {
 	pq[i], pq[j] = pq[j], pq[i]
 	pq[i].index = i
 	pq[j].index = j
 }
 
-func (pq *PriorityQueue) Push(x interface{}) {
-	item := x.(*Item)
+func (pq *PriorityQueue[S]) Push(x interface{}) {
+	item := x.(*Item[S])
 	item.index = len(*pq)
 	*pq = append(*pq, item)
 }
 
-func (pq *PriorityQueue) Pop() interface{} {
+func (pq *PriorityQueue[S]) Pop() interface{} {
 	old := *pq
 	n := len(old)
 	item := old[n-1]
@@ -146,77 +140,7 @@ func (pq *PriorityQueue) Pop() interface{} {
 
 // Dijkstra's algorithm - returns path and shortest distance from start to target
 func dijkstra(start, target *Node) ([]*Node, int) {
-	distances := make(map[*Node]int)
-	previous := make(map[*Node]*Node)
-	visited := make(map[*Node]bool)
-
-	// Initialize distances
-	var allNodes []*Node
-	if start != nil {
-		// Collect all nodes reachable from start
-		var collect func(*Node)
-		collect = func(n *Node) {
-			if visited[n] {
-				return
-			}
-			visited[n] = true
-			allNodes = append(allNodes, n)
-			for _, adj := range n.adjacent {
-				collect(adj)
-			}
-		}
-		collect(start)
-	}
-
-	for _, node := range allNodes {
-		distances[node] = math.MaxInt
-	}
-	distances[start] = 0
-	visited = make(map[*Node]bool) // Reset for algorithm
-
-	pq := make(PriorityQueue, 0)
-	heap.Push(&pq, &Item{node: start, distance: 0})
-
-	for pq.Len() > 0 {
-		current := heap.Pop(&pq).(*Item)
-		currentNode := current.node
-		currentDist := current.distance
-
-		if visited[currentNode] {
-			continue
-		}
-		visited[currentNode] = true
-
-		if currentNode == target {
-			// Reconstruct path
-			var path []*Node
-			node := target
-			for node != nil {
-				path = append([]*Node{node}, path...)
-				node = previous[node]
-			}
-			return path, distances[target]
-		}
-
-		// Check all adjacent nodes
-		for _, adj := range currentNode.adjacent {
-			if visited[adj] {
-				continue
-			}
-
-			weight := currentNode.edges[adj]
-			newDist := currentDist + weight
-
-			if newDist < distances[adj] {
-				distances[adj] = newDist
-				previous[adj] = currentNode
-				heap.Push(&pq, &Item{node: adj, distance: newDist})
-			}
-		}
-	}
-
-	// Target not reachable
-	return nil, -1
+	return implicitDijkstra(start, (*Node).outEdges, func(n *Node) bool { return n == target })
 }
 
 func (g *Graph) prettyPrint() string {
@@ -0,0 +1,106 @@
+package graphs
+
+import "container/heap"
+
+// Edge is a weighted transition to a neighbouring state. Implicit graphs are
+// described by a function returning the edges leaving a state, so puzzle states,
+// word ladders or board positions can be searched without building a Graph.
+type Edge[S comparable] struct {
+	To     S
+	Weight int
+}
+
+// reconstructPath follows previous links back from end until it reaches the
+// start, which is the only visited state without a previous entry
+func reconstructPath[S comparable](previous map[S]S, end S) []S {
+	path := []S{end}
+	for state, ok := previous[end]; ok; state, ok = previous[state] {
+		path = append(path, state)
+	}
+	for i, j := 0, len(path)-1; i < j; i, j = i+1, j-1 {
+		path[i], path[j] = path[j], path[i]
+	}
+	return path
+}
+
+// Breadth first search over an implicit graph - returns the path with the fewest
+// edges from start to the first state satisfying goal. Edge weights are ignored.
+//
+// Time Complexity: O(V+E) over the states actually explored
+// Space Complexity: O(V)
+func implicitBFS[S comparable](start S, neighbours func(S) []Edge[S], goal func(S) bool) ([]S, bool) {
+	visited := map[S]bool{start: true}
+	previous := make(map[S]S)
+	// Expand one level at a time, as bfsLevels does, swapping the two buffers
+	// so states are visited in FIFO order without re-slicing a queue
+	frontier, next := []S{start}, []S(nil)
+	for len(frontier) > 0 {
+		for _, state := range frontier {
+			if goal(state) {
+				return reconstructPath(previous, state), true
+			}
+			for _, edge := range neighbours(state) {
+				if !visited[edge.To] {
+					visited[edge.To] = true
+					previous[edge.To] = state
+					next = append(next, edge.To)
+				}
+			}
+		}
+		frontier, next = next, frontier[:0]
+	}
+	return nil, false
+}
+
+// Dijkstra's algorithm over an implicit graph - returns the cheapest path from start
+// to a goal state and its cost, or nil and -1 if no goal is reachable.
+// Weights must be non-negative.
+//
+// Time Complexity: O(E log E) over the states actually explored
+// Space Complexity: O(V+E)
+func implicitDijkstra[S comparable](start S, neighbours func(S) []Edge[S], goal func(S) bool) ([]S, int) {
+	return implicitAStar(start, neighbours, goal, func(S) int { return 0 })
+}
+
+// A* search - Dijkstra's algorithm ordered by distance so far plus heuristic(state),
+// an estimate of the remaining cost. The heuristic must be consistent (never
+// decrease by more than the edge weight along any edge, and 0 at goals) for the
+// first path found to be the cheapest; a heuristic of 0 gives plain Dijkstra.
+//
+// Time Complexity: O(E log E) worst case, usually far fewer states than Dijkstra
+// Space Complexity: O(V+E)
+func implicitAStar[S comparable](start S, neighbours func(S) []Edge[S], goal func(S) bool, heuristic func(S) int) ([]S, int) {
+	distances := map[S]int{start: 0}
+	previous := make(map[S]S)
+	visited := make(map[S]bool)
+
+	pq := make(PriorityQueue[S], 0)
+	heap.Push(&pq, &Item[S]{state: start, distance: 0, priority: heuristic(start)})
+
+	for pq.Len() > 0 {
+		current := heap.Pop(&pq).(*Item[S])
+		if visited[current.state] {
+			continue
+		}
+		visited[current.state] = true
+
+		if goal(current.state) {
+			return reconstructPath(previous, current.state), current.distance
+		}
+
+		for _, edge := range neighbours(current.state) {
+			if visited[edge.To] {
+				continue
+			}
+			newDist := current.distance + edge.Weight
+			if best, seen := distances[edge.To]; !seen || newDist < best {
+				distances[edge.To] = newDist
+				previous[edge.To] = current.state
+				heap.Push(&pq, &Item[S]{state: edge.To, distance: newDist, priority: newDist + heuristic(edge.To)})
+			}
+		}
+	}
+
+	// Goal not reachable
+	return nil, -1
+}
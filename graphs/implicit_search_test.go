@@ -0,0 +1,137 @@
+package graphs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type square struct{ row, col int }
+
+// knightMoves generates the legal knight moves on an n x n board
+func knightMoves(n int) func(square) []Edge[square] {
+	return func(s square) []Edge[square] {
+		var moves []Edge[square]
+		for _, d := range [][2]int{{1, 2}, {2, 1}, {2, -1}, {1, -2}, {-1, -2}, {-2, -1}, {-2, 1}, {-1, 2}} {
+			next := square{s.row + d[0], s.col + d[1]}
+			if next.row >= 0 && next.row < n && next.col >= 0 && next.col < n {
+				moves = append(moves, Edge[square]{To: next, Weight: 1})
+			}
+		}
+		return moves
+	}
+}
+
+func TestImplicitBFSKnightMoves(t *testing.T) {
+	goal := square{7, 7}
+	path, found := implicitBFS(square{0, 0}, knightMoves(8), func(s square) bool { return s == goal })
+	assert.True(t, found)
+	assert.Equal(t, 6, len(path)-1) // corner to corner takes 6 moves
+	assert.Equal(t, square{0, 0}, path[0])
+	assert.Equal(t, goal, path[len(path)-1])
+}
+
+func TestImplicitBFSStartIsGoal(t *testing.T) {
+	path, found := implicitBFS(3, func(int) []Edge[int] { return nil }, func(s int) bool { return s == 3 })
+	assert.True(t, found)
+	assert.Equal(t, []int{3}, path)
+}
+
+func TestImplicitBFSUnreachable(t *testing.T) {
+	// On a 2x2 board the knight cannot move at all
+	path, found := implicitBFS(square{0, 0}, knightMoves(2), func(s square) bool { return s == square{1, 1} })
+	assert.False(t, found)
+	assert.Nil(t, path)
+}
+
+func TestImplicitBFSWordLadder(t *testing.T) {
+	dictionary := map[string]bool{"hot": true, "dot": true, "dog": true, "lot": true, "log": true, "cog": true}
+	neighbours := func(word string) []Edge[string] {
+		var edges []Edge[string]
+		for i := range word {
+			for c := 'a'; c <= 'z'; c++ {
+				next := word[:i] + string(c) + word[i+1:]
+				if next != word && dictionary[next] {
+					edges = append(edges, Edge[string]{To: next, Weight: 1})
+				}
+			}
+		}
+		return edges
+	}
+
+	path, found := implicitBFS("hit", neighbours, func(w string) bool { return w == "cog" })
+	assert.True(t, found)
+	assert.Equal(t, 5, len(path))
+	assert.Equal(t, "hit", path[0])
+	assert.Equal(t, "hot", path[1])
+	assert.Equal(t, "cog", path[4])
+}
+
+// gridNeighbours moves up/down/left/right on an open grid, with entering a wall
+// square disallowed and entering a swamp square costing 5
+func gridNeighbours(walls, swamps map[square]bool, size int) func(square) []Edge[square] {
+	return func(s square) []Edge[square] {
+		var edges []Edge[square]
+		for _, d := range [][2]int{{0, 1}, {1, 0}, {0, -1}, {-1, 0}} {
+			next := square{s.row + d[0], s.col + d[1]}
+			if next.row < 0 || next.row >= size || next.col < 0 || next.col >= size || walls[next] {
+				continue
+			}
+			weight := 1
+			if swamps[next] {
+				weight = 5
+			}
+			edges = append(edges, Edge[square]{To: next, Weight: weight})
+		}
+		return edges
+	}
+}
+
+func TestImplicitDijkstraAndAStarAgree(t *testing.T) {
+	walls := map[square]bool{{1, 0}: true, {1, 1}: true, {1, 2}: true, {3, 4}: true, {3, 3}: true}
+	swamps := map[square]bool{{0, 3}: true, {2, 2}: true}
+	neighbours := gridNeighbours(walls, swamps, 5)
+	goal := square{4, 4}
+	isGoal := func(s square) bool { return s == goal }
+	manhattan := func(s square) int { return (goal.row - s.row) + (goal.col - s.col) }
+
+	dPath, dDist := implicitDijkstra(square{0, 0}, neighbours, isGoal)
+	aPath, aDist := implicitAStar(square{0, 0}, neighbours, isGoal, manhattan)
+	assert.Equal(t, dDist, aDist)
+	assert.Equal(t, 18, dDist) // the only way around the walls crosses both swamps
+
+	// Both paths are valid and cost what they claim
+	for _, path := range [][]square{dPath, aPath} {
+		cost := 0
+		for i := 1; i < len(path); i++ {
+			assert.False(t, walls[path[i]])
+			cost++
+			if swamps[path[i]] {
+				cost += 4
+			}
+		}
+		assert.Equal(t, dDist, cost)
+	}
+}
+
+func TestImplicitAStarUnreachable(t *testing.T) {
+	walls := map[square]bool{{0, 1}: true, {1, 0}: true}
+	path, dist := implicitAStar(square{0, 0}, gridNeighbours(walls, nil, 3), func(s square) bool { return s == square{2, 2} },
+		func(square) int { return 0 })
+	assert.Nil(t, path)
+	assert.Equal(t, -1, dist)
+}
+
+func TestImplicitDijkstraOnExplicitGraph(t *testing.T) {
+	g := &Graph{}
+	node1 := g.addNode(1)
+	node2 := g.addNode(2)
+	node3 := g.addNode(3)
+	g.addWeightedEdge(node1, node2, 2)
+	g.addWeightedEdge(node2, node3, 2)
+	g.addWeightedEdge(node1, node3, 5)
+
+	path, dist := implicitDijkstra(node1, (*Node).outEdges, func(n *Node) bool { return n.value == 3 })
+	assert.Equal(t, 4, dist)
+	assert.Equal(t, []*Node{node1, node2, node3}, path)
+}
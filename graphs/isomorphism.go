@@ -0,0 +1,328 @@
+package graphs
+
+import "iter"
+
+// MatchOptions restricts which nodes and edges may be paired by the isomorphism
+// search. A nil predicate matches everything, so only structure is compared.
+type MatchOptions struct {
+	// NodeMatch reports whether a node of the first (pattern) graph may be mapped
+	// onto a node of the second (target) graph, e.g. comparing values
+	NodeMatch func(pattern, target *Node) bool
+	// EdgeMatch reports whether a pattern edge may be mapped onto a target edge,
+	// given the weights stored by addWeightedEdge (1 for addEdge)
+	EdgeMatch func(patternWeight, targetWeight int) bool
+}
+
+// vf2Graph is a graph flattened to integer indices with deduplicated adjacency
+// and a bitset per node so edge existence checks are O(1)
+type vf2Graph struct {
+	nodes []*Node
+	succ  [][]int
+	pred  [][]int
+	edge  []bitset
+}
+
+func newVF2Graph(g *Graph) *vf2Graph {
+	index := g.nodeIndex()
+	n := len(g.nodes)
+	vg := &vf2Graph{nodes: g.nodes, succ: make([][]int, n), pred: make([][]int, n), edge: make([]bitset, n)}
+	for i := range g.nodes {
+		vg.edge[i] = newBitset(n)
+	}
+	for i, node := range g.nodes {
+		for _, adj := range node.adjacent {
+			j, ok := index[adj]
+			if !ok || vg.edge[i].has(j) {
+				continue // edge to a node that is not in g, or a duplicate edge
+			}
+			vg.edge[i].set(j)
+			vg.succ[i] = append(vg.succ[i], j)
+			vg.pred[j] = append(vg.pred[j], i)
+		}
+	}
+	return vg
+}
+
+func (vg *vf2Graph) edgeCount() int {
+	total := 0
+	for _, s := range vg.succ {
+		total += len(s)
+	}
+	return total
+}
+
+func (vg *vf2Graph) weight(i, j int) int {
+	return vg.nodes[i].edges[vg.nodes[j]]
+}
+
+// vf2Side is one half of the search state: the partial mapping for one graph and
+// the depth at which each node entered the in/out terminal sets (0 = not in set)
+type vf2Side struct {
+	g    *vf2Graph
+	core []int
+	in   []int
+	out  []int
+}
+
+func newVF2Side(g *vf2Graph) *vf2Side {
+	core := make([]int, len(g.nodes))
+	for i := range core {
+		core[i] = -1
+	}
+	return &vf2Side{g: g, core: core, in: make([]int, len(g.nodes)), out: make([]int, len(g.nodes))}
+}
+
+func (s *vf2Side) add(node, other, depth int) {
+	s.core[node] = other
+	if s.in[node] == 0 {
+		s.in[node] = depth
+	}
+	if s.out[node] == 0 {
+		s.out[node] = depth
+	}
+	for _, p := range s.g.pred[node] {
+		if s.in[p] == 0 {
+			s.in[p] = depth
+		}
+	}
+	for _, q := range s.g.succ[node] {
+		if s.out[q] == 0 {
+			s.out[q] = depth
+		}
+	}
+}
+
+func (s *vf2Side) remove(node, depth int) {
+	s.core[node] = -1
+	undo := func(i int) {
+		if s.in[i] == depth {
+			s.in[i] = 0
+		}
+		if s.out[i] == depth {
+			s.out[i] = 0
+		}
+	}
+	undo(node)
+	for _, p := range s.g.pred[node] {
+		undo(p)
+	}
+	for _, q := range s.g.succ[node] {
+		undo(q)
+	}
+}
+
+// terminal returns the unmapped nodes in the out (or in) terminal set
+func (s *vf2Side) terminal(out bool) []int {
+	set := s.in
+	if out {
+		set = s.out
+	}
+	var result []int
+	for i, depth := range set {
+		if depth != 0 && s.core[i] == -1 {
+			result = append(result, i)
+		}
+	}
+	return result
+}
+
+func (s *vf2Side) unmapped() []int {
+	var result []int
+	for i, c := range s.core {
+		if c == -1 {
+			result = append(result, i)
+		}
+	}
+	return result
+}
+
+// lookahead counts the unmapped neighbours of node that are in the in terminal
+// set, the out terminal set and neither, separately for predecessors and successors
+func (s *vf2Side) lookahead(node int) [6]int {
+	var counts [6]int
+	tally := func(neighbours []int, offset int) {
+		for _, i := range neighbours {
+			if s.core[i] != -1 {
+				continue
+			}
+			if s.in[i] != 0 {
+				counts[offset]++
+			}
+			if s.out[i] != 0 {
+				counts[offset+1]++
+			}
+			if s.in[i] == 0 && s.out[i] == 0 {
+				counts[offset+2]++
+			}
+		}
+	}
+	tally(s.g.pred[node], 0)
+	tally(s.g.succ[node], 3)
+	return counts
+}
+
+type vf2Matcher struct {
+	pattern  *vf2Side
+	target   *vf2Side
+	opts     MatchOptions
+	subgraph bool // induced subgraph search rather than full isomorphism
+}
+
+// feasible applies the VF2 rules to the candidate pair (u, v): every edge between u
+// and an already mapped node must exist on both sides, and the lookahead counts
+// must leave enough room on the target side to finish the mapping.
+func (m *vf2Matcher) feasible(u, v int) bool {
+	p, t := m.pattern, m.target
+	if m.opts.NodeMatch != nil && !m.opts.NodeMatch(p.g.nodes[u], t.g.nodes[v]) {
+		return false
+	}
+	if p.g.edge[u].has(u) != t.g.edge[v].has(v) {
+		return false
+	}
+	if p.g.edge[u].has(u) && !m.edgeMatches(u, u, v, v) {
+		return false
+	}
+
+	for _, q := range p.g.succ[u] {
+		if mapped := p.core[q]; mapped != -1 && q != u {
+			if !t.g.edge[v].has(mapped) || !m.edgeMatches(u, q, v, mapped) {
+				return false
+			}
+		}
+	}
+	for _, q := range p.g.pred[u] {
+		if mapped := p.core[q]; mapped != -1 && q != u {
+			if !t.g.edge[mapped].has(v) || !m.edgeMatches(q, u, mapped, v) {
+				return false
+			}
+		}
+	}
+	// The reverse direction makes the match induced: no extra target edges
+	// between mapped nodes are allowed
+	for _, q := range t.g.succ[v] {
+		if mapped := t.core[q]; mapped != -1 && q != v && !p.g.edge[u].has(mapped) {
+			return false
+		}
+	}
+	for _, q := range t.g.pred[v] {
+		if mapped := t.core[q]; mapped != -1 && q != v && !p.g.edge[mapped].has(u) {
+			return false
+		}
+	}
+
+	patternCounts, targetCounts := p.lookahead(u), t.lookahead(v)
+	for i := range patternCounts {
+		if m.subgraph && patternCounts[i] > targetCounts[i] {
+			return false
+		}
+		if !m.subgraph && patternCounts[i] != targetCounts[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func (m *vf2Matcher) edgeMatches(pu, pv, tu, tv int) bool {
+	if m.opts.EdgeMatch == nil {
+		return true
+	}
+	return m.opts.EdgeMatch(m.pattern.g.weight(pu, pv), m.target.g.weight(tu, tv))
+}
+
+// candidates picks the next pattern node (the lowest index in the out terminal
+// set, then the in terminal set, then any unmapped node) and the target nodes it
+// could be paired with
+func (m *vf2Matcher) candidates() (int, []int) {
+	for _, out := range []bool{true, false} {
+		if pt := m.pattern.terminal(out); len(pt) > 0 {
+			return pt[0], m.target.terminal(out)
+		}
+	}
+	return m.pattern.unmapped()[0], m.target.unmapped()
+}
+
+func (m *vf2Matcher) search(depth int, yield func(map[*Node]*Node) bool) bool {
+	if depth > len(m.pattern.core) {
+		mapping := make(map[*Node]*Node, len(m.pattern.core))
+		for i, j := range m.pattern.core {
+			mapping[m.pattern.g.nodes[i]] = m.target.g.nodes[j]
+		}
+		return yield(mapping)
+	}
+
+	u, options := m.candidates()
+	for _, v := range options {
+		if !m.feasible(u, v) {
+			continue
+		}
+		m.pattern.add(u, v, depth)
+		m.target.add(v, u, depth)
+		keepGoing := m.search(depth+1, yield)
+		m.pattern.remove(u, depth)
+		m.target.remove(v, depth)
+		if !keepGoing {
+			return false
+		}
+	}
+	return true
+}
+
+func newVF2Matcher(pattern, target *Graph, opts MatchOptions, subgraph bool) *vf2Matcher {
+	return &vf2Matcher{
+		pattern:  newVF2Side(newVF2Graph(pattern)),
+		target:   newVF2Side(newVF2Graph(target)),
+		opts:     opts,
+		subgraph: subgraph,
+	}
+}
+
+// VF2 (Cordella, Foggia, Sansone & Vento) - enumerates every isomorphism between
+// g1 and g2 as a map from g1's nodes to g2's nodes. The search extends a partial
+// mapping one pair at a time, only pairing nodes adjacent to what is already
+// mapped and pruning with counts of the remaining neighbours.
+//
+// Time Complexity: O(V^2) best case, O(V! * V) worst case
+// Space Complexity: O(V^2 / 64) for the adjacency bitsets
+func isomorphisms(g1, g2 *Graph, opts MatchOptions) iter.Seq[map[*Node]*Node] {
+	return func(yield func(map[*Node]*Node) bool) {
+		if len(g1.nodes) != len(g2.nodes) {
+			return
+		}
+		m := newVF2Matcher(g1, g2, opts, false)
+		if m.pattern.g.edgeCount() != m.target.g.edgeCount() {
+			return
+		}
+		m.search(1, yield)
+	}
+}
+
+// isomorphic returns the first isomorphism found from g1 to g2, if any
+func isomorphic(g1, g2 *Graph, opts MatchOptions) (map[*Node]*Node, bool) {
+	for mapping := range isomorphisms(g1, g2, opts) {
+		return mapping, true
+	}
+	return nil, false
+}
+
+// Subgraph isomorphism - enumerates every way pattern appears as an induced
+// subgraph of target: mapped nodes are joined in target exactly when they are
+// joined in pattern. Each mapping goes from pattern's nodes to target's nodes.
+//
+// Time Complexity: O(V^2) best case, O(V! * V) worst case in the target size
+// Space Complexity: O(V^2 / 64)
+func subgraphIsomorphisms(pattern, target *Graph, opts MatchOptions) iter.Seq[map[*Node]*Node] {
+	return func(yield func(map[*Node]*Node) bool) {
+		if len(pattern.nodes) > len(target.nodes) {
+			return
+		}
+		newVF2Matcher(pattern, target, opts, true).search(1, yield)
+	}
+}
+
+// findSubgraph returns the first occurrence of pattern inside target, if any
+func findSubgraph(pattern, target *Graph, opts MatchOptions) (map[*Node]*Node, bool) {
+	for mapping := range subgraphIsomorphisms(pattern, target, opts) {
+		return mapping, true
+	}
+	return nil, false
+}
@@ -0,0 +1,194 @@
+package graphs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// buildGraph creates nodes with the given values and an edge for each pair of indices
+func buildGraph(vals []int, edges [][2]int) *Graph {
+	g := &Graph{}
+	for _, v := range vals {
+		g.addNode(v)
+	}
+	for _, e := range edges {
+		g.addEdge(g.nodes[e[0]], g.nodes[e[1]])
+	}
+	return g
+}
+
+// assertPreservesEdges checks that every edge between mapped pattern nodes exists in the target
+func assertPreservesEdges(t *testing.T, pattern *Graph, mapping map[*Node]*Node) {
+	for _, n := range pattern.nodes {
+		for _, adj := range n.adjacent {
+			assert.Contains(t, mapping[n].adjacent, mapping[adj])
+		}
+	}
+}
+
+func TestIsomorphic(t *testing.T) {
+	// 1 -> 2 -> 3 -> 1 plus 1 -> 4
+	g1 := buildGraph([]int{1, 2, 3, 4}, [][2]int{{0, 1}, {1, 2}, {2, 0}, {0, 3}})
+	// Same shape with the nodes shuffled: 40 -> 30 -> 20 -> 40 plus 40 -> 10
+	g2 := buildGraph([]int{10, 20, 30, 40}, [][2]int{{3, 2}, {2, 1}, {1, 3}, {3, 0}})
+
+	mapping, ok := isomorphic(g1, g2, MatchOptions{})
+	assert.True(t, ok)
+	assert.Equal(t, 4, len(mapping))
+	assert.Equal(t, 40, mapping[g1.nodes[0]].value)
+	assert.Equal(t, 10, mapping[g1.nodes[3]].value)
+	assertPreservesEdges(t, g1, mapping)
+}
+
+func TestNotIsomorphic(t *testing.T) {
+	// Directed path 1 -> 2 -> 3 versus a node with two out edges
+	path := buildGraph([]int{1, 2, 3}, [][2]int{{0, 1}, {1, 2}})
+	star := buildGraph([]int{1, 2, 3}, [][2]int{{0, 1}, {0, 2}})
+	_, ok := isomorphic(path, star, MatchOptions{})
+	assert.False(t, ok)
+
+	// Different sizes
+	_, ok = isomorphic(path, buildGraph([]int{1, 2}, [][2]int{{0, 1}}), MatchOptions{})
+	assert.False(t, ok)
+
+	// Direction matters
+	reversed := buildGraph([]int{1, 2, 3}, [][2]int{{1, 0}, {2, 0}})
+	_, ok = isomorphic(star, reversed, MatchOptions{})
+	assert.False(t, ok)
+}
+
+func TestIsomorphismsEnumeratesAutomorphisms(t *testing.T) {
+	// A directed 3-cycle maps onto itself in 3 ways (rotations)
+	cycle := buildGraph([]int{1, 2, 3}, [][2]int{{0, 1}, {1, 2}, {2, 0}})
+	count := 0
+	for mapping := range isomorphisms(cycle, cycle, MatchOptions{}) {
+		assertPreservesEdges(t, cycle, mapping)
+		count++
+	}
+	assert.Equal(t, 3, count)
+}
+
+func TestIsomorphismNodeMatch(t *testing.T) {
+	cycle := buildGraph([]int{1, 2, 3}, [][2]int{{0, 1}, {1, 2}, {2, 0}})
+	sameValue := MatchOptions{NodeMatch: func(a, b *Node) bool { return a.value == b.value }}
+
+	count := 0
+	for mapping := range isomorphisms(cycle, cycle, sameValue) {
+		for from, to := range mapping {
+			assert.Equal(t, from, to)
+		}
+		count++
+	}
+	assert.Equal(t, 1, count)
+}
+
+func TestIsomorphismEdgeMatch(t *testing.T) {
+	g1 := &Graph{}
+	a, b := g1.addNode(1), g1.addNode(2)
+	g1.addWeightedEdge(a, b, 5)
+
+	g2 := &Graph{}
+	c, d := g2.addNode(1), g2.addNode(2)
+	g2.addWeightedEdge(c, d, 7)
+
+	sameWeight := MatchOptions{EdgeMatch: func(w1, w2 int) bool { return w1 == w2 }}
+	_, ok := isomorphic(g1, g2, sameWeight)
+	assert.False(t, ok)
+
+	_, ok = isomorphic(g1, g2, MatchOptions{})
+	assert.True(t, ok)
+}
+
+func TestIsomorphismSelfLoops(t *testing.T) {
+	loop := buildGraph([]int{1, 2}, [][2]int{{0, 0}, {0, 1}})
+	noLoop := buildGraph([]int{1, 2}, [][2]int{{0, 1}, {1, 0}})
+	_, ok := isomorphic(loop, noLoop, MatchOptions{})
+	assert.False(t, ok)
+
+	mapping, ok := isomorphic(loop, buildGraph([]int{5, 6}, [][2]int{{1, 0}, {1, 1}}), MatchOptions{})
+	assert.True(t, ok)
+	assert.Equal(t, 6, mapping[loop.nodes[0]].value)
+}
+
+func TestIsomorphismEmptyGraphs(t *testing.T) {
+	mapping, ok := isomorphic(&Graph{}, &Graph{}, MatchOptions{})
+	assert.True(t, ok)
+	assert.Empty(t, mapping)
+}
+
+func TestSubgraphIsomorphism(t *testing.T) {
+	// Target: a directed triangle 0 -> 1 -> 2 -> 0 hanging off a tail 3 -> 0, 2 -> 4
+	target := buildGraph([]int{10, 11, 12, 13, 14}, [][2]int{{0, 1}, {1, 2}, {2, 0}, {3, 0}, {2, 4}})
+	triangle := buildGraph([]int{1, 2, 3}, [][2]int{{0, 1}, {1, 2}, {2, 0}})
+
+	count := 0
+	for mapping := range subgraphIsomorphisms(triangle, target, MatchOptions{}) {
+		assertPreservesEdges(t, triangle, mapping)
+		for _, to := range mapping {
+			assert.Contains(t, []int{10, 11, 12}, to.value)
+		}
+		count++
+	}
+	assert.Equal(t, 3, count)
+}
+
+func TestSubgraphIsomorphismIsInduced(t *testing.T) {
+	// A path 1 -> 2 -> 3 is not an induced subgraph of a triangle because of the closing edge
+	path := buildGraph([]int{1, 2, 3}, [][2]int{{0, 1}, {1, 2}})
+	triangle := buildGraph([]int{1, 2, 3}, [][2]int{{0, 1}, {1, 2}, {2, 0}})
+	_, ok := findSubgraph(path, triangle, MatchOptions{})
+	assert.False(t, ok)
+
+	// But it is inside a longer path
+	longPath := buildGraph([]int{1, 2, 3, 4}, [][2]int{{0, 1}, {1, 2}, {2, 3}})
+	count := 0
+	for range subgraphIsomorphisms(path, longPath, MatchOptions{}) {
+		count++
+	}
+	assert.Equal(t, 2, count)
+}
+
+func TestSubgraphIsomorphismWithPredicates(t *testing.T) {
+	target := buildGraph([]int{1, 2, 1, 3}, [][2]int{{0, 1}, {2, 3}})
+	pattern := buildGraph([]int{1, 3}, [][2]int{{0, 1}})
+	sameValue := MatchOptions{NodeMatch: func(a, b *Node) bool { return a.value == b.value }}
+
+	mapping, ok := findSubgraph(pattern, target, sameValue)
+	assert.True(t, ok)
+	assert.Same(t, target.nodes[2], mapping[pattern.nodes[0]])
+	assert.Same(t, target.nodes[3], mapping[pattern.nodes[1]])
+
+	_, ok = findSubgraph(buildGraph([]int{1, 2, 3}, nil), buildGraph([]int{1, 2}, nil), MatchOptions{})
+	assert.False(t, ok)
+}
+
+func TestSubgraphIsomorphismStopsEarly(t *testing.T) {
+	// Four isolated nodes contain a single node 4 ways; stop after the first
+	target := buildGraph([]int{1, 2, 3, 4}, nil)
+	pattern := buildGraph([]int{1}, nil)
+	count := 0
+	for range subgraphIsomorphisms(pattern, target, MatchOptions{}) {
+		count++
+		break
+	}
+	assert.Equal(t, 1, count)
+}
+
+func TestIsomorphismSkipsEdgesOutsideGraph(t *testing.T) {
+	// 1 -> 2 with a dangling edge 2 -> removed node
+	g1 := buildGraph([]int{1, 2, 3}, [][2]int{{0, 1}})
+	removed := g1.nodes[2]
+	g1.removeNode(removed)
+	g1.addEdge(g1.nodes[1], removed)
+
+	path := buildGraph([]int{10, 20}, [][2]int{{0, 1}})
+	mapping, ok := isomorphic(g1, path, MatchOptions{})
+	assert.True(t, ok)
+	assert.Equal(t, 10, mapping[g1.nodes[0]].value)
+
+	// The dangling edge must not be mistaken for an edge back to node 1
+	cycle := buildGraph([]int{10, 20}, [][2]int{{0, 1}, {1, 0}})
+	_, ok = isomorphic(g1, cycle, MatchOptions{})
+	assert.False(t, ok)
+}
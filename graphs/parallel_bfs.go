@@ -0,0 +1,137 @@
+package graphs
+
+import (
+	"runtime"
+	"sync"
+	"sync/atomic"
+)
+
+// atomicBitset is a bitset that many goroutines can claim bits in concurrently
+type atomicBitset []atomic.Uint64
+
+func newAtomicBitset(size int) atomicBitset {
+	return make(atomicBitset, (size+63)/64)
+}
+
+// trySet sets bit i and reports whether this call was the one that set it
+func (b atomicBitset) trySet(i int) bool {
+	mask := uint64(1) << (uint(i) % 64)
+	return b[i/64].Or(mask)&mask == 0
+}
+
+// Sequential level-synchronous BFS - returns the number of edges on the shortest
+// path from source to each node, indexed like g.nodes, with -1 for unreachable
+// nodes. Each level's frontier is a fresh slice, so nothing is resliced.
+//
+// Time Complexity: O(V+E)
+// Space Complexity: O(V)
+func (g *Graph) bfsDistances(source *Node) []int {
+	v := g.view()
+	start, ok := v.index[source]
+	if !ok {
+		return unreachableDistances(len(g.nodes))
+	}
+	return bfsLevels(v, start)
+}
+
+func unreachableDistances(n int) []int {
+	distances := make([]int, n)
+	for i := range distances {
+		distances[i] = -1
+	}
+	return distances
+}
+
+func bfsLevels(v GraphView, start int) []int {
+	distances := unreachableDistances(v.order())
+	distances[start] = 0
+	frontier := []int{start}
+	for level := 1; len(frontier) > 0; level++ {
+		var next []int
+		for _, i := range frontier {
+			targets, _ := v.edgesFrom(i)
+			for _, t := range targets {
+				if j := int(t); distances[j] == -1 {
+					distances[j] = level
+					next = append(next, j)
+				}
+			}
+		}
+		frontier = next
+	}
+	return distances
+}
+
+// frontiers smaller than this are expanded on the calling goroutine, where the cost
+// of starting workers would outweigh the work
+const parallelFrontierThreshold = 1024
+
+// Parallel level-synchronous BFS - every node in the current frontier is at the
+// same distance, so the frontier is split across workers that expand their share
+// concurrently. A node is claimed by whichever worker atomically sets its visited
+// bit first; only that worker writes its distance and adds it to the next
+// frontier, so no other locking is needed. workers <= 0 uses GOMAXPROCS.
+//
+// Time Complexity: O((V+E) / workers + depth) given enough parallelism
+// Space Complexity: O(V)
+func (g *Graph) parallelBFSDistances(source *Node, workers int) []int {
+	v := g.view()
+	start, ok := v.index[source]
+	if !ok {
+		return unreachableDistances(len(g.nodes))
+	}
+	return parallelBFSLevels(v, start, workers)
+}
+
+func parallelBFSLevels(v GraphView, start, workers int) []int {
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	distances := unreachableDistances(v.order())
+	visited := newAtomicBitset(v.order())
+	visited.trySet(start)
+	distances[start] = 0
+
+	expand := func(frontier []int, level int) []int {
+		var next []int
+		for _, i := range frontier {
+			targets, _ := v.edgesFrom(i)
+			for _, t := range targets {
+				if j := int(t); visited.trySet(j) {
+					distances[j] = level
+					next = append(next, j)
+				}
+			}
+		}
+		return next
+	}
+
+	frontier := []int{start}
+	for level := 1; len(frontier) > 0; level++ {
+		if len(frontier) < parallelFrontierThreshold || workers == 1 {
+			frontier = expand(frontier, level)
+			continue
+		}
+
+		chunk := (len(frontier) + workers - 1) / workers
+		parts := make([][]int, workers)
+		var wg sync.WaitGroup
+		for w := 0; w < workers; w++ {
+			lo := w * chunk
+			hi := min(lo+chunk, len(frontier))
+			if lo >= hi {
+				break
+			}
+			wg.Go(func() {
+				parts[w] = expand(frontier[lo:hi], level)
+			})
+		}
+		wg.Wait()
+
+		frontier = frontier[:0:0]
+		for _, part := range parts {
+			frontier = append(frontier, part...)
+		}
+	}
+	return distances
+}
@@ -0,0 +1,64 @@
+package graphs
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// randomGraph builds a directed graph with n nodes and n*degree random edges
+func randomGraph(n, degree int, seed int64) *Graph {
+	r := rand.New(rand.NewSource(seed))
+	g := &Graph{}
+	for i := 0; i < n; i++ {
+		g.addNode(i)
+	}
+	for i := 0; i < n*degree; i++ {
+		g.addEdge(g.nodes[r.Intn(n)], g.nodes[r.Intn(n)])
+	}
+	return g
+}
+
+func TestBFSDistances(t *testing.T) {
+	// 1 -> 2 -> 3, 1 -> 3, 4 unreachable
+	g := buildGraph([]int{1, 2, 3, 4}, [][2]int{{0, 1}, {1, 2}, {0, 2}, {3, 0}})
+	assert.Equal(t, []int{0, 1, 1, -1}, g.bfsDistances(g.nodes[0]))
+	assert.Equal(t, []int{0, 1, 1, -1}, g.parallelBFSDistances(g.nodes[0], 4))
+	assert.Equal(t, []int{1, 2, 2, 0}, g.parallelBFSDistances(g.nodes[3], 4))
+}
+
+func TestBFSDistancesUnknownSource(t *testing.T) {
+	g := buildGraph([]int{1, 2}, [][2]int{{0, 1}})
+	assert.Equal(t, []int{-1, -1}, g.bfsDistances(&Node{}))
+	assert.Equal(t, []int{-1, -1}, g.parallelBFSDistances(&Node{}, 2))
+}
+
+func TestParallelBFSMatchesSequential(t *testing.T) {
+	// Large enough that frontiers cross parallelFrontierThreshold
+	g := randomGraph(50000, 4, 1)
+	expected := g.bfsDistances(g.nodes[0])
+	for _, workers := range []int{0, 1, 3, 8} {
+		assert.Equal(t, expected, g.parallelBFSDistances(g.nodes[0], workers))
+	}
+
+	// Distances agree with plain reachability for a sample of nodes
+	for _, i := range []int{1, 100, 4999, 49999} {
+		assert.Equal(t, breadthFirstSearch(g.nodes[0], g.nodes[i]), expected[i] >= 0)
+	}
+}
+
+// The benchmarks freeze the graph once so they measure the search only
+func BenchmarkBFSDistancesSequential(b *testing.B) {
+	csr := randomGraph(1_000_000, 8, 1).freeze()
+	for b.Loop() {
+		bfsLevels(csr, 0)
+	}
+}
+
+func BenchmarkBFSDistancesParallel(b *testing.B) {
+	csr := randomGraph(1_000_000, 8, 1).freeze()
+	for b.Loop() {
+		parallelBFSLevels(csr, 0, 0)
+	}
+}
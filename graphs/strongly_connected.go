@@ -0,0 +1,59 @@
+package graphs
+
+// Tarjan's algorithm - a single depth first search that assigns each node the
+// lowest discovery index reachable from its subtree. A node whose low link equals
+// its own index is the root of a strongly connected component, which is then
+// popped off the stack. Components are returned in reverse topological order of
+// the condensed graph: no component has an edge to a component returned after it.
+//
+// Time Complexity: O(V+E)
+// Space Complexity: O(V)
+func (g *Graph) stronglyConnectedComponents() [][]*Node {
+	inGraph := g.nodeIndex()
+	index := make(map[*Node]int, len(g.nodes))
+	lowLink := make(map[*Node]int, len(g.nodes))
+	onStack := make(map[*Node]bool)
+	var stack []*Node
+	var components [][]*Node
+
+	var strongConnect func(n *Node)
+	strongConnect = func(n *Node) {
+		index[n] = len(index)
+		lowLink[n] = index[n]
+		stack = append(stack, n)
+		onStack[n] = true
+
+		for _, adj := range n.adjacent {
+			if _, ok := inGraph[adj]; !ok {
+				continue // edge to a node that is not in g
+			}
+			if _, visited := index[adj]; !visited {
+				strongConnect(adj)
+				lowLink[n] = min(lowLink[n], lowLink[adj])
+			} else if onStack[adj] {
+				lowLink[n] = min(lowLink[n], index[adj])
+			}
+		}
+
+		if lowLink[n] == index[n] {
+			var component []*Node
+			for {
+				top := stack[len(stack)-1]
+				stack = stack[:len(stack)-1]
+				onStack[top] = false
+				component = append(component, top)
+				if top == n {
+					break
+				}
+			}
+			components = append(components, component)
+		}
+	}
+
+	for _, n := range g.nodes {
+		if _, visited := index[n]; !visited {
+			strongConnect(n)
+		}
+	}
+	return components
+}
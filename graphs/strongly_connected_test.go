@@ -0,0 +1,42 @@
+package graphs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStronglyConnectedComponents(t *testing.T) {
+	// {1,2,3} form a cycle, 3 -> 4, {4,5} form a cycle, 6 stands alone
+	g := buildGraph([]int{1, 2, 3, 4, 5, 6}, [][2]int{{0, 1}, {1, 2}, {2, 0}, {2, 3}, {3, 4}, {4, 3}, {5, 0}})
+
+	components := g.stronglyConnectedComponents()
+	assert.Equal(t, 3, len(components))
+	assert.ElementsMatch(t, []int{4, 5}, nodeValues(components[0]))
+	assert.ElementsMatch(t, []int{1, 2, 3}, nodeValues(components[1]))
+	assert.ElementsMatch(t, []int{6}, nodeValues(components[2]))
+
+	// A dangling edge to a removed node adds no component
+	removed := g.addNode(7)
+	g.removeNode(removed)
+	g.addEdge(g.nodes[5], removed)
+	components = g.stronglyConnectedComponents()
+	assert.Equal(t, 3, len(components))
+	assert.ElementsMatch(t, []int{6}, nodeValues(components[2]))
+}
+
+func TestStronglyConnectedComponentsDAG(t *testing.T) {
+	g := buildGraph([]int{1, 2, 3}, [][2]int{{0, 1}, {1, 2}})
+
+	components := g.stronglyConnectedComponents()
+	assert.Equal(t, 3, len(components))
+	// Reverse topological order: sinks first
+	assert.Equal(t, []int{3}, nodeValues(components[0]))
+	assert.Equal(t, []int{2}, nodeValues(components[1]))
+	assert.Equal(t, []int{1}, nodeValues(components[2]))
+}
+
+func TestStronglyConnectedComponentsEmptyGraph(t *testing.T) {
+	g := &Graph{}
+	assert.Empty(t, g.stronglyConnectedComponents())
+}
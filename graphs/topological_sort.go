@@ -0,0 +1,117 @@
+package graphs
+
+import (
+	"fmt"
+	"strings"
+)
+
+// CycleError is returned by algorithms that require a directed acyclic graph
+// when the input contains a cycle. Cycle lists the nodes of one such cycle in
+// edge order, starting and ending at the same node.
+type CycleError struct {
+	Cycle []*Node
+}
+
+func (e *CycleError) Error() string {
+	vals := make([]string, len(e.Cycle))
+	for i, n := range e.Cycle {
+		vals[i] = fmt.Sprintf("%d", n.value)
+	}
+	return "graph contains a cycle: " + strings.Join(vals, " -> ")
+}
+
+// nodeIndex maps every node of the graph to its position in g.nodes so that
+// algorithms can use slices and bitsets instead of maps keyed by *Node. Edges
+// can point at nodes that are not in g.nodes (a removed node, or one that was
+// never added), so callers must check ok and skip those edges.
+func (g *Graph) nodeIndex() map[*Node]int {
+	index := make(map[*Node]int, len(g.nodes))
+	for i, n := range g.nodes {
+		index[n] = i
+	}
+	return index
+}
+
+// Kahn's algorithm - repeatedly remove nodes with no incoming edges.
+// Returns the nodes in an order where every edge points forward, or a
+// *CycleError if no such order exists.
+//
+// Time Complexity: O(V+E)
+// Space Complexity: O(V)
+func (g *Graph) topologicalSort() ([]*Node, error) {
+	index := g.nodeIndex()
+	inDegree := make([]int, len(g.nodes))
+	for _, n := range g.nodes {
+		for _, adj := range n.adjacent {
+			if i, ok := index[adj]; ok {
+				inDegree[i]++
+			}
+		}
+	}
+
+	var queue []*Node
+	for i, n := range g.nodes {
+		if inDegree[i] == 0 {
+			queue = append(queue, n)
+		}
+	}
+
+	order := make([]*Node, 0, len(g.nodes))
+	for len(queue) > 0 {
+		node := queue[0]
+		queue = queue[1:]
+		order = append(order, node)
+		for _, adj := range node.adjacent {
+			i, ok := index[adj]
+			if !ok {
+				continue // edge to a node that is not in g
+			}
+			inDegree[i]--
+			if inDegree[i] == 0 {
+				queue = append(queue, adj)
+			}
+		}
+	}
+
+	if len(order) < len(g.nodes) {
+		return nil, &CycleError{Cycle: g.findCycle(index, inDegree)}
+	}
+	return order, nil
+}
+
+// findCycle extracts one cycle from the nodes Kahn's algorithm could not remove.
+// Every such node still has a predecessor that was not removed either, so walking
+// predecessors backwards must eventually revisit a node.
+func (g *Graph) findCycle(index map[*Node]int, inDegree []int) []*Node {
+	predecessor := make([]*Node, len(g.nodes))
+	var start *Node
+	for _, n := range g.nodes {
+		if inDegree[index[n]] == 0 {
+			continue
+		}
+		for _, adj := range n.adjacent {
+			if i, ok := index[adj]; ok && inDegree[i] > 0 {
+				predecessor[i] = n
+			}
+		}
+		start = n
+	}
+
+	seen := make(map[*Node]bool)
+	node := start
+	for !seen[node] {
+		seen[node] = true
+		node = predecessor[index[node]]
+	}
+
+	// node is on the cycle; walk it once more to collect it, then reverse into edge order
+	cycle := []*Node{node}
+	for prev := predecessor[index[node]]; prev != node; prev = predecessor[index[prev]] {
+		cycle = append(cycle, prev)
+	}
+	cycle = append(cycle, node)
+	for i, j := 0, len(cycle)-1; i < j; i, j = i+1, j-1 {
+		cycle[i], cycle[j] = cycle[j], cycle[i]
+	}
+	return cycle
+}
@@ -0,0 +1,102 @@
+package graphs
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTopologicalSort(t *testing.T) {
+	g := &Graph{}
+
+	node1 := g.addNode(1)
+	node2 := g.addNode(2)
+	node3 := g.addNode(3)
+	node4 := g.addNode(4)
+
+	g.addEdge(node3, node1)
+	g.addEdge(node1, node2)
+	g.addEdge(node3, node4)
+	g.addEdge(node4, node2)
+
+	order, err := g.topologicalSort()
+	assert.NoError(t, err)
+	assert.Equal(t, 4, len(order))
+
+	position := make(map[*Node]int)
+	for i, n := range order {
+		position[n] = i
+	}
+	for _, n := range g.nodes {
+		for _, adj := range n.adjacent {
+			assert.Less(t, position[n], position[adj])
+		}
+	}
+}
+
+func TestTopologicalSortEmptyGraph(t *testing.T) {
+	g := &Graph{}
+	order, err := g.topologicalSort()
+	assert.NoError(t, err)
+	assert.Empty(t, order)
+}
+
+func TestTopologicalSortWithCycle(t *testing.T) {
+	g := &Graph{}
+
+	node1 := g.addNode(1)
+	node2 := g.addNode(2)
+	node3 := g.addNode(3)
+	node4 := g.addNode(4)
+
+	g.addEdge(node4, node1)
+	g.addEdge(node1, node2)
+	g.addEdge(node2, node3)
+	g.addEdge(node3, node1) // Cycle
+	g.addEdge(node1, node4) // Second cycle through node4
+
+	order, err := g.topologicalSort()
+	assert.Nil(t, order)
+
+	var cycleErr *CycleError
+	assert.True(t, errors.As(err, &cycleErr))
+
+	// The reported cycle must start and end at the same node and follow real edges
+	cycle := cycleErr.Cycle
+	assert.GreaterOrEqual(t, len(cycle), 2)
+	assert.Equal(t, cycle[0], cycle[len(cycle)-1])
+	for i := 0; i+1 < len(cycle); i++ {
+		assert.Contains(t, cycle[i].adjacent, cycle[i+1])
+	}
+	assert.Contains(t, err.Error(), "graph contains a cycle")
+}
+
+func TestTopologicalSortSelfLoop(t *testing.T) {
+	g := &Graph{}
+	node1 := g.addNode(1)
+	g.addEdge(node1, node1)
+
+	_, err := g.topologicalSort()
+	assert.EqualError(t, err, "graph contains a cycle: 1 -> 1")
+}
+
+func TestTopologicalSortSkipsEdgesOutsideGraph(t *testing.T) {
+	g := &Graph{}
+	node1 := g.addNode(1)
+	node2 := g.addNode(2)
+	node3 := g.addNode(3)
+	g.removeNode(node3)
+	g.addEdge(node2, node3) // dangling edge to a removed node
+	g.addEdge(node2, node1)
+
+	order, err := g.topologicalSort()
+	assert.NoError(t, err)
+	assert.Equal(t, []*Node{node2, node1}, order)
+
+	// A real cycle is still reported even with a dangling edge on it
+	g.addEdge(node1, node2)
+	_, err = g.topologicalSort()
+	var cycleErr *CycleError
+	assert.True(t, errors.As(err, &cycleErr))
+}
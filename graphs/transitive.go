@@ -0,0 +1,116 @@
+package graphs
+
+// Reachability answers "can A reach B" in O(1) after an O(V^3/64) precomputation.
+// Row i holds one bit per node index for every node reachable from g.nodes[i]
+// through a path of at least one edge, so a node only reaches itself when it
+// lies on a cycle.
+type Reachability struct {
+	index map[*Node]int
+	rows  []bitset
+}
+
+// Warshall's algorithm over bitset rows: if i reaches k then i also reaches
+// everything k reaches, and whole rows can be merged 64 nodes at a time.
+//
+// Time Complexity: O(V^3 / 64) plus O(V+E) to seed the rows
+// Space Complexity: O(V^2 / 64)
+func newReachability(g *Graph) *Reachability {
+	index := g.nodeIndex()
+	rows := make([]bitset, len(g.nodes))
+	for i, n := range g.nodes {
+		rows[i] = newBitset(len(g.nodes))
+		for _, adj := range n.adjacent {
+			if j, ok := index[adj]; ok {
+				rows[i].set(j)
+			}
+		}
+	}
+
+	for k := range rows {
+		for i := range rows {
+			if rows[i].has(k) {
+				rows[i].or(rows[k])
+			}
+		}
+	}
+	return &Reachability{index: index, rows: rows}
+}
+
+func (r *Reachability) canReach(from, to *Node) bool {
+	i, ok := r.index[from]
+	if !ok {
+		return false
+	}
+	j, ok := r.index[to]
+	if !ok {
+		return false
+	}
+	return r.rows[i].has(j)
+}
+
+// copyNodes creates a new graph holding a fresh node for each node of g with the
+// same value and in the same order, so result.nodes[i] corresponds to g.nodes[i]
+func (g *Graph) copyNodes() *Graph {
+	result := &Graph{}
+	for _, n := range g.nodes {
+		result.addNode(n.value)
+	}
+	return result
+}
+
+// Transitive closure - returns a new graph with an edge from A to B whenever B is
+// reachable from A in g. Nodes of the result correspond to g.nodes by position.
+// Works on any directed graph; nodes on a cycle get a self-loop.
+//
+// Time Complexity: O(V^3 / 64 + V^2) the second term for materialising edges
+// Space Complexity: O(V^2)
+func (g *Graph) transitiveClosure() *Graph {
+	reach := newReachability(g)
+	result := g.copyNodes()
+	for i, row := range reach.rows {
+		for j := range g.nodes {
+			if row.has(j) {
+				result.addEdge(result.nodes[i], result.nodes[j])
+			}
+		}
+	}
+	return result
+}
+
+// Transitive reduction of a DAG - returns a new graph with the fewest edges that
+// preserves reachability. An edge u -> v is redundant exactly when v can also be
+// reached through some other successor of u. Kept edges retain their weight.
+// Returns a *CycleError if g is not acyclic, where the reduction is not unique.
+//
+// Time Complexity: O(V^3 / 64 + V*E)
+// Space Complexity: O(V^2 / 64)
+func (g *Graph) transitiveReduction() (*Graph, error) {
+	if _, err := g.topologicalSort(); err != nil {
+		return nil, err
+	}
+
+	reach := newReachability(g)
+	result := g.copyNodes()
+	for i, n := range g.nodes {
+		added := make(map[int]bool)
+		for _, v := range n.adjacent {
+			j, ok := reach.index[v]
+			if !ok || added[j] {
+				continue // edge to a node that is not in g, or a duplicate edge
+			}
+			redundant := false
+			for _, w := range n.adjacent {
+				k, ok := reach.index[w]
+				if ok && w != v && reach.rows[k].has(j) {
+					redundant = true
+					break
+				}
+			}
+			if !redundant {
+				result.addWeightedEdge(result.nodes[i], result.nodes[j], n.edges[v])
+				added[j] = true
+			}
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,145 @@
+package graphs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReachability(t *testing.T) {
+	g := &Graph{}
+
+	node1 := g.addNode(1)
+	node2 := g.addNode(2)
+	node3 := g.addNode(3)
+	node4 := g.addNode(4)
+
+	g.addEdge(node1, node2)
+	g.addEdge(node2, node3)
+	g.addEdge(node3, node2) // Cycle between 2 and 3
+
+	reach := newReachability(g)
+	assert.True(t, reach.canReach(node1, node3))
+	assert.True(t, reach.canReach(node2, node2)) // on a cycle
+	assert.False(t, reach.canReach(node1, node1))
+	assert.False(t, reach.canReach(node3, node1))
+	assert.False(t, reach.canReach(node1, node4))
+	assert.False(t, reach.canReach(node1, &Node{}))
+
+	// Agrees with the single-pair search for every pair not on a cycle
+	for _, a := range g.nodes {
+		for _, b := range g.nodes {
+			if a != b {
+				assert.Equal(t, depthFirstSearch(a, b), reach.canReach(a, b))
+			}
+		}
+	}
+}
+
+func TestTransitiveClosure(t *testing.T) {
+	g := &Graph{}
+
+	node1 := g.addNode(1)
+	node2 := g.addNode(2)
+	node3 := g.addNode(3)
+	node4 := g.addNode(4)
+
+	g.addEdge(node1, node2)
+	g.addEdge(node2, node3)
+	g.addEdge(node3, node4)
+
+	closure := g.transitiveClosure()
+	expected := "Node 1: [2 3 4]\nNode 2: [3 4]\nNode 3: [4]\nNode 4: []\n"
+	assert.Equal(t, expected, closure.prettyPrint())
+
+	// The original graph is untouched
+	assert.Equal(t, "Node 1: [2]\nNode 2: [3]\nNode 3: [4]\nNode 4: []\n", g.prettyPrint())
+}
+
+func TestTransitiveClosureWithCycle(t *testing.T) {
+	g := &Graph{}
+
+	node1 := g.addNode(1)
+	node2 := g.addNode(2)
+
+	g.addEdge(node1, node2)
+	g.addEdge(node2, node1)
+
+	closure := g.transitiveClosure()
+	expected := "Node 1: [1 2]\nNode 2: [1 2]\n"
+	assert.Equal(t, expected, closure.prettyPrint())
+}
+
+func TestTransitiveReduction(t *testing.T) {
+	g := &Graph{}
+
+	node1 := g.addNode(1)
+	node2 := g.addNode(2)
+	node3 := g.addNode(3)
+	node4 := g.addNode(4)
+
+	g.addWeightedEdge(node1, node2, 5)
+	g.addEdge(node1, node3) // Redundant via 2
+	g.addEdge(node1, node4) // Redundant via 2 -> 3
+	g.addWeightedEdge(node2, node3, 7)
+	g.addEdge(node3, node4)
+	g.addEdge(node2, node4) // Redundant via 3
+
+	reduction, err := g.transitiveReduction()
+	assert.NoError(t, err)
+	expected := "Node 1: [2]\nNode 2: [3]\nNode 3: [4]\nNode 4: []\n"
+	assert.Equal(t, expected, reduction.prettyPrint())
+	assert.Equal(t, 5, reduction.nodes[0].edges[reduction.nodes[1]])
+	assert.Equal(t, 7, reduction.nodes[1].edges[reduction.nodes[2]])
+
+	// Reachability is preserved
+	assert.Equal(t, g.transitiveClosure().prettyPrint(), reduction.transitiveClosure().prettyPrint())
+}
+
+func TestTransitiveReductionDuplicateEdges(t *testing.T) {
+	g := &Graph{}
+
+	node1 := g.addNode(1)
+	node2 := g.addNode(2)
+
+	g.addEdge(node1, node2)
+	g.addEdge(node1, node2)
+
+	reduction, err := g.transitiveReduction()
+	assert.NoError(t, err)
+	assert.Equal(t, "Node 1: [2]\nNode 2: []\n", reduction.prettyPrint())
+}
+
+func TestTransitiveReductionWithCycle(t *testing.T) {
+	g := &Graph{}
+
+	node1 := g.addNode(1)
+	node2 := g.addNode(2)
+
+	g.addEdge(node1, node2)
+	g.addEdge(node2, node1)
+
+	reduction, err := g.transitiveReduction()
+	assert.Nil(t, reduction)
+	assert.IsType(t, &CycleError{}, err)
+}
+
+func TestReachabilitySkipsEdgesOutsideGraph(t *testing.T) {
+	g := &Graph{}
+	node1 := g.addNode(1)
+	node2 := g.addNode(2)
+	node3 := g.addNode(3)
+	g.removeNode(node3)
+	g.addEdge(node2, node3) // dangling edge to a removed node
+	g.addEdge(node1, node2)
+
+	reach := newReachability(g)
+	assert.False(t, reach.canReach(node2, node1))
+	assert.False(t, reach.canReach(node2, node3))
+	assert.True(t, reach.canReach(node1, node2))
+
+	reduced, err := g.transitiveReduction()
+	assert.NoError(t, err)
+	assert.Len(t, reduced.nodes[0].adjacent, 1)
+	assert.Empty(t, reduced.nodes[1].adjacent)
+}
@@ -0,0 +1,91 @@
+package graphs
+
+import "fmt"
+
+// Literal is a named boolean variable, or its negation when Negated is set
+type Literal struct {
+	Name    string
+	Negated bool
+}
+
+func (l Literal) String() string {
+	if l.Negated {
+		return "!" + l.Name
+	}
+	return l.Name
+}
+
+// UnsatisfiableError reports a variable that is forced to be both true and false:
+// its positive and negative literals imply each other
+type UnsatisfiableError struct {
+	Variable string
+}
+
+func (e *UnsatisfiableError) Error() string {
+	return fmt.Sprintf("unsatisfiable: %s implies !%s and !%s implies %s", e.Variable, e.Variable, e.Variable, e.Variable)
+}
+
+// TwoSAT collects clauses of the form (a OR b) over named variables and builds the
+// implication graph: each variable x has a node for x and one for !x, and the
+// clause (a OR b) adds the edges !a -> b and !b -> a.
+type TwoSAT struct {
+	graph     *Graph
+	variables map[string]int // variable name -> node index of its positive literal
+	names     []string
+}
+
+func newTwoSAT() *TwoSAT {
+	return &TwoSAT{graph: &Graph{}, variables: make(map[string]int)}
+}
+
+// literalNode returns the implication graph node for l, adding the variable's pair
+// of nodes the first time it is seen. Positive literals are at even indices and
+// their negation immediately after.
+func (s *TwoSAT) literalNode(l Literal) *Node {
+	i, ok := s.variables[l.Name]
+	if !ok {
+		i = len(s.graph.nodes)
+		s.variables[l.Name] = i
+		s.names = append(s.names, l.Name)
+		s.graph.addNode(i)
+		s.graph.addNode(i + 1)
+	}
+	if l.Negated {
+		return s.graph.nodes[i+1]
+	}
+	return s.graph.nodes[i]
+}
+
+func (s *TwoSAT) addClause(a, b Literal) {
+	notA := s.literalNode(Literal{Name: a.Name, Negated: !a.Negated})
+	notB := s.literalNode(Literal{Name: b.Name, Negated: !b.Negated})
+	s.graph.addEdge(notA, s.literalNode(b))
+	s.graph.addEdge(notB, s.literalNode(a))
+}
+
+// 2-SAT via strongly connected components - the formula is unsatisfiable exactly
+// when some x and !x are in the same component. Otherwise set x true when its
+// component comes after !x's in topological order; since Tarjan's algorithm emits
+// components in reverse topological order that is when x's component id is lower.
+//
+// Time Complexity: O(V+C) for V variables and C clauses
+// Space Complexity: O(V+C)
+func (s *TwoSAT) solve() (map[string]bool, error) {
+	component := make(map[*Node]int, len(s.graph.nodes))
+	for id, nodes := range s.graph.stronglyConnectedComponents() {
+		for _, n := range nodes {
+			component[n] = id
+		}
+	}
+
+	assignment := make(map[string]bool, len(s.names))
+	for _, name := range s.names {
+		positive := s.graph.nodes[s.variables[name]]
+		negative := s.graph.nodes[s.variables[name]+1]
+		if component[positive] == component[negative] {
+			return nil, &UnsatisfiableError{Variable: name}
+		}
+		assignment[name] = component[positive] < component[negative]
+	}
+	return assignment, nil
+}
@@ -0,0 +1,116 @@
+package graphs
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func pos(name string) Literal { return Literal{Name: name} }
+func neg(name string) Literal { return Literal{Name: name, Negated: true} }
+
+func satisfies(assignment map[string]bool, clauses [][2]Literal) bool {
+	value := func(l Literal) bool { return assignment[l.Name] != l.Negated }
+	for _, c := range clauses {
+		if !value(c[0]) && !value(c[1]) {
+			return false
+		}
+	}
+	return true
+}
+
+func TestTwoSATSatisfiable(t *testing.T) {
+	clauses := [][2]Literal{
+		{pos("a"), neg("b")},
+		{pos("b"), pos("c")},
+		{neg("a"), neg("c")},
+		{pos("c"), pos("d")},
+		{neg("d"), pos("a")},
+	}
+	s := newTwoSAT()
+	for _, c := range clauses {
+		s.addClause(c[0], c[1])
+	}
+
+	assignment, err := s.solve()
+	assert.NoError(t, err)
+	assert.Equal(t, 4, len(assignment))
+	assert.True(t, satisfies(assignment, clauses))
+}
+
+func TestTwoSATForcedValue(t *testing.T) {
+	// (a OR a) forces a, and (!a OR b) then forces b
+	s := newTwoSAT()
+	s.addClause(pos("a"), pos("a"))
+	s.addClause(neg("a"), pos("b"))
+
+	assignment, err := s.solve()
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]bool{"a": true, "b": true}, assignment)
+}
+
+func TestTwoSATUnsatisfiable(t *testing.T) {
+	// a must equal b, b must differ from a
+	s := newTwoSAT()
+	s.addClause(pos("a"), neg("b"))
+	s.addClause(neg("a"), pos("b"))
+	s.addClause(pos("a"), pos("b"))
+	s.addClause(neg("a"), neg("b"))
+
+	assignment, err := s.solve()
+	assert.Nil(t, assignment)
+
+	var unsat *UnsatisfiableError
+	assert.True(t, errors.As(err, &unsat))
+	assert.Equal(t, "a", unsat.Variable)
+	assert.EqualError(t, err, "unsatisfiable: a implies !a and !a implies a")
+}
+
+func TestTwoSATNoClauses(t *testing.T) {
+	assignment, err := newTwoSAT().solve()
+	assert.NoError(t, err)
+	assert.Empty(t, assignment)
+}
+
+func TestTwoSATExhaustive(t *testing.T) {
+	// Every formula over three variables with up to three clauses agrees with brute force
+	names := []string{"x", "y", "z"}
+	var literals []Literal
+	for _, n := range names {
+		literals = append(literals, pos(n), neg(n))
+	}
+	var allClauses [][2]Literal
+	for i := range literals {
+		for j := i; j < len(literals); j++ {
+			allClauses = append(allClauses, [2]Literal{literals[i], literals[j]})
+		}
+	}
+
+	bruteForce := func(clauses [][2]Literal) bool {
+		for mask := 0; mask < 8; mask++ {
+			assignment := map[string]bool{"x": mask&1 != 0, "y": mask&2 != 0, "z": mask&4 != 0}
+			if satisfies(assignment, clauses) {
+				return true
+			}
+		}
+		return false
+	}
+
+	for i := range allClauses {
+		for j := i; j < len(allClauses); j++ {
+			for k := j; k < len(allClauses); k++ {
+				clauses := [][2]Literal{allClauses[i], allClauses[j], allClauses[k]}
+				s := newTwoSAT()
+				for _, c := range clauses {
+					s.addClause(c[0], c[1])
+				}
+				assignment, err := s.solve()
+				assert.Equal(t, bruteForce(clauses), err == nil)
+				if err == nil {
+					assert.True(t, satisfies(assignment, clauses))
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,235 @@
+package heap
+
+import "fmt"
+
+// binomialItem is the handle for a value in a BinomialHeap. DecreaseKey moves
+// values up the tree by swapping them between nodes, so handles point at the
+// item, which follows its value around, rather than at a fixed node.
+type binomialItem[T any] struct {
+	value   T
+	node    *binomialNode[T]
+	owner   *heapOwner
+	removed bool
+}
+
+func (i *binomialItem[T]) Value() T { return i.value }
+
+type binomialNode[T any] struct {
+	item    *binomialItem[T]
+	parent  *binomialNode[T]
+	child   *binomialNode[T] // child of highest degree
+	sibling *binomialNode[T] // next root, or next child of lower degree
+	degree  int
+}
+
+// BinomialHeap is a list of binomial trees in increasing order of degree, at
+// most one per degree - like the binary digits of its size. A tree of degree k
+// has 2^k nodes and is two degree k-1 trees linked together. Melding is adding
+// the two "numbers": merge the root lists by degree and link trees of equal
+// degree like carrying a 1.
+type BinomialHeap[T any] struct {
+	head  *binomialNode[T]
+	size  int
+	less  func(a, b T) bool
+	owner ownership
+}
+
+var _ MergeableHeap[int, *BinomialHeap[int]] = (*BinomialHeap[int])(nil)
+
+func NewBinomialHeap[T any](less func(a, b T) bool) *BinomialHeap[T] {
+	return &BinomialHeap[T]{less: less}
+}
+
+func (h *BinomialHeap[T]) Len() int { return h.size }
+
+func (h *BinomialHeap[T]) Push(v T) Node[T] {
+	item := &binomialItem[T]{value: v, owner: h.owner.token()}
+	item.node = &binomialNode[T]{item: item}
+	h.head = h.union(h.head, item.node)
+	h.size++
+	return item
+}
+
+// minRoot returns the root holding the least value and the root before it
+func (h *BinomialHeap[T]) minRoot() (best, beforeBest *binomialNode[T]) {
+	var prev *binomialNode[T]
+	for n := h.head; n != nil; prev, n = n, n.sibling {
+		if best == nil || h.less(n.item.value, best.item.value) {
+			best, beforeBest = n, prev
+		}
+	}
+	return best, beforeBest
+}
+
+// Peek scans the O(log n) roots
+func (h *BinomialHeap[T]) Peek() (v T, ok bool) {
+	best, _ := h.minRoot()
+	if best == nil {
+		return v, false
+	}
+	return best.item.value, true
+}
+
+// Pop removes the least root; its children, in reverse, form a binomial heap of
+// their own that is melded back in
+func (h *BinomialHeap[T]) Pop() (v T, ok bool) {
+	best, beforeBest := h.minRoot()
+	if best == nil {
+		return v, false
+	}
+	if beforeBest == nil {
+		h.head = best.sibling
+	} else {
+		beforeBest.sibling = best.sibling
+	}
+
+	var children *binomialNode[T]
+	for c := best.child; c != nil; {
+		next := c.sibling
+		c.parent = nil
+		c.sibling = children
+		children = c
+		c = next
+	}
+	h.head = h.union(h.head, children)
+	h.size--
+
+	best.item.removed = true
+	best.item.node = nil
+	return best.item.value, true
+}
+
+func (h *BinomialHeap[T]) Meld(other *BinomialHeap[T]) {
+	if other == h {
+		return
+	}
+	h.head = h.union(h.head, other.head)
+	h.size += other.size
+	other.head, other.size = nil, 0
+	h.owner.absorb(&other.owner)
+}
+
+// union melds two root lists: merge them by degree, then walk the result linking
+// neighbouring trees of equal degree. When three trees share a degree (two from
+// the inputs plus one carried) the first is left alone and the later two linked.
+func (h *BinomialHeap[T]) union(a, b *binomialNode[T]) *binomialNode[T] {
+	head := mergeByDegree(a, b)
+	if head == nil {
+		return nil
+	}
+
+	var prev *binomialNode[T]
+	x, next := head, head.sibling
+	for next != nil {
+		if x.degree != next.degree || (next.sibling != nil && next.sibling.degree == x.degree) {
+			prev, x = x, next
+		} else if !h.less(next.item.value, x.item.value) {
+			x.sibling = next.sibling
+			linkBinomial(next, x)
+		} else {
+			if prev == nil {
+				head = next
+			} else {
+				prev.sibling = next
+			}
+			linkBinomial(x, next)
+			x = next
+		}
+		next = x.sibling
+	}
+	return head
+}
+
+// mergeByDegree merges two root lists sorted by degree, like mergeTwoSortedLists
+func mergeByDegree[T any](a, b *binomialNode[T]) *binomialNode[T] {
+	dummyHead := &binomialNode[T]{}
+	tail := dummyHead
+	for a != nil && b != nil {
+		if a.degree <= b.degree {
+			tail.sibling, a = a, a.sibling
+		} else {
+			tail.sibling, b = b, b.sibling
+		}
+		tail = tail.sibling
+	}
+	if a != nil {
+		tail.sibling = a
+	} else {
+		tail.sibling = b
+	}
+	return dummyHead.sibling
+}
+
+// linkBinomial makes child, a root of the same degree as parent, its first child
+func linkBinomial[T any](child, parent *binomialNode[T]) {
+	child.parent = parent
+	child.sibling = parent.child
+	parent.child = child
+	parent.degree++
+}
+
+// DecreaseKey lowers the value and swaps it up towards the root while it is less
+// than its parent's. Trees have depth O(log n).
+func (h *BinomialHeap[T]) DecreaseKey(n Node[T], v T) bool {
+	item, ok := n.(*binomialItem[T])
+	if !ok || item.removed || !h.owner.owns(item.owner) || h.less(item.value, v) {
+		return false
+	}
+	item.value = v
+
+	node := item.node
+	for node.parent != nil && h.less(node.item.value, node.parent.item.value) {
+		parent := node.parent
+		node.item, parent.item = parent.item, node.item
+		node.item.node = node
+		parent.item.node = parent
+		node = parent
+	}
+	return true
+}
+
+// validate checks the shape of every tree, heap order, the item back pointers
+// and the size
+func (h *BinomialHeap[T]) validate() error {
+	count := 0
+	var check func(n *binomialNode[T]) error
+	check = func(n *binomialNode[T]) error {
+		count++
+		if n.item.node != n {
+			return fmt.Errorf("item %v points at the wrong node", n.item.value)
+		}
+		// The children of a degree k node have degrees k-1, k-2, ..., 0
+		want := n.degree - 1
+		for c := n.child; c != nil; c = c.sibling {
+			if c.degree != want || c.parent != n {
+				return fmt.Errorf("bad child of %v", n.item.value)
+			}
+			if h.less(c.item.value, n.item.value) {
+				return fmt.Errorf("child %v is less than its parent %v", c.item.value, n.item.value)
+			}
+			if err := check(c); err != nil {
+				return err
+			}
+			want--
+		}
+		if want != -1 {
+			return fmt.Errorf("node %v has degree %d but the wrong number of children", n.item.value, n.degree)
+		}
+		return nil
+	}
+
+	lastDegree := -1
+	for root := h.head; root != nil; root = root.sibling {
+		if root.degree <= lastDegree || root.parent != nil {
+			return fmt.Errorf("root list out of order at degree %d", root.degree)
+		}
+		lastDegree = root.degree
+		if err := check(root); err != nil {
+			return err
+		}
+	}
+	if count != h.size {
+		return fmt.Errorf("size is %d but found %d nodes", h.size, count)
+	}
+	return nil
+}
@@ -0,0 +1,292 @@
+package heap
+
+import "fmt"
+
+type fibNode[T any] struct {
+	value  T
+	parent *fibNode[T]
+	child  *fibNode[T] // any one child; the children form a circular list
+	// left and right link the node into the circular list of its siblings, or of
+	// the roots
+	left, right *fibNode[T]
+	degree      int
+	// mark records that the node has lost a child since it last became a child
+	// itself; losing a second one cuts it from its parent too
+	mark    bool
+	owner   *heapOwner
+	removed bool
+}
+
+func (n *fibNode[T]) Value() T { return n.value }
+
+// FibonacciHeap is a collection of heap-ordered trees whose roots form a circular
+// doubly linked list, with min pointing at the least root. Push and Meld just
+// add to the root list; Pop pays for the laziness by consolidating the roots
+// until no two share a degree. DecreaseKey cuts a node that violates heap order
+// to the root list, and the cascading cuts of marked ancestors keep a node of
+// degree k with at least F(k+2) descendants, which bounds degrees by O(log n).
+type FibonacciHeap[T any] struct {
+	min   *fibNode[T]
+	size  int
+	less  func(a, b T) bool
+	owner ownership
+	// scratch space for consolidate, kept between calls to save allocations
+	roots, byDegree []*fibNode[T]
+}
+
+var _ MergeableHeap[int, *FibonacciHeap[int]] = (*FibonacciHeap[int])(nil)
+
+func NewFibonacciHeap[T any](less func(a, b T) bool) *FibonacciHeap[T] {
+	return &FibonacciHeap[T]{less: less}
+}
+
+func (h *FibonacciHeap[T]) Len() int { return h.size }
+
+// spliceRight joins the circular list containing b in just to the right of a
+func spliceRight[T any](a, b *fibNode[T]) {
+	aRight, bLeft := a.right, b.left
+	a.right = b
+	b.left = a
+	bLeft.right = aRight
+	aRight.left = bLeft
+}
+
+// unlink removes n from its circular list, leaving it a list of one
+func unlink[T any](n *fibNode[T]) {
+	n.left.right = n.right
+	n.right.left = n.left
+	n.left, n.right = n, n
+}
+
+// addRoot puts a single node or a whole circular list into the root list
+func (h *FibonacciHeap[T]) addRoot(n *fibNode[T]) {
+	if h.min == nil {
+		h.min = n
+		return
+	}
+	spliceRight(h.min, n)
+	if h.less(n.value, h.min.value) {
+		h.min = n
+	}
+}
+
+func (h *FibonacciHeap[T]) Push(v T) Node[T] {
+	n := &fibNode[T]{value: v, owner: h.owner.token()}
+	n.left, n.right = n, n
+	h.addRoot(n)
+	h.size++
+	return n
+}
+
+func (h *FibonacciHeap[T]) Peek() (v T, ok bool) {
+	if h.min == nil {
+		return v, false
+	}
+	return h.min.value, true
+}
+
+// Meld splices the two root lists together in O(1)
+func (h *FibonacciHeap[T]) Meld(other *FibonacciHeap[T]) {
+	if other == h {
+		return
+	}
+	h.owner.absorb(&other.owner)
+	if other.min == nil {
+		return
+	}
+	if h.min == nil {
+		h.min = other.min
+	} else {
+		spliceRight(h.min, other.min)
+		if h.less(other.min.value, h.min.value) {
+			h.min = other.min
+		}
+	}
+	h.size += other.size
+	other.min, other.size = nil, 0
+}
+
+// Pop moves the minimum's children to the root list, removes it and consolidates
+func (h *FibonacciHeap[T]) Pop() (v T, ok bool) {
+	top := h.min
+	if top == nil {
+		return v, false
+	}
+
+	if child := top.child; child != nil {
+		c := child
+		for {
+			c.parent = nil
+			c.mark = false
+			c = c.right
+			if c == child {
+				break
+			}
+		}
+		spliceRight(top, child)
+		top.child = nil
+	}
+
+	if top.right == top {
+		h.min = nil
+	} else {
+		h.min = top.right
+		unlink(top)
+		h.consolidate()
+	}
+	h.size--
+	top.degree = 0
+	top.removed = true
+	return top.value, true
+}
+
+// consolidate links roots of equal degree, the smaller value becoming the parent,
+// until every root has a distinct degree, then rebuilds the root list and min
+func (h *FibonacciHeap[T]) consolidate() {
+	roots := h.roots[:0]
+	for r := h.min; ; {
+		roots = append(roots, r)
+		r = r.right
+		if r == h.min {
+			break
+		}
+	}
+
+	byDegree := h.byDegree[:0]
+	for _, x := range roots {
+		x.left, x.right = x, x
+		for {
+			d := x.degree
+			for d >= len(byDegree) {
+				byDegree = append(byDegree, nil)
+			}
+			y := byDegree[d]
+			if y == nil {
+				byDegree[d] = x
+				break
+			}
+			byDegree[d] = nil
+			if h.less(y.value, x.value) {
+				x, y = y, x
+			}
+			h.link(y, x)
+		}
+	}
+
+	h.min = nil
+	for _, r := range byDegree {
+		if r != nil {
+			h.addRoot(r)
+		}
+	}
+
+	// Drop the node pointers so popped nodes can be collected
+	clear(roots)
+	clear(byDegree)
+	h.roots, h.byDegree = roots, byDegree
+}
+
+// link makes the root y a child of the root x
+func (h *FibonacciHeap[T]) link(y, x *fibNode[T]) {
+	y.parent = x
+	y.mark = false
+	if x.child == nil {
+		x.child = y
+	} else {
+		spliceRight(x.child, y)
+	}
+	x.degree++
+}
+
+func (h *FibonacciHeap[T]) DecreaseKey(n Node[T], v T) bool {
+	x, ok := n.(*fibNode[T])
+	if !ok || x.removed || !h.owner.owns(x.owner) || h.less(x.value, v) {
+		return false
+	}
+	x.value = v
+
+	if parent := x.parent; parent != nil && h.less(x.value, parent.value) {
+		h.cut(x)
+		// Cascading cut: marked ancestors have now lost two children
+		for y := parent; y.parent != nil; {
+			if !y.mark {
+				y.mark = true
+				break
+			}
+			next := y.parent
+			h.cut(y)
+			y = next
+		}
+	}
+	if h.less(x.value, h.min.value) {
+		h.min = x
+	}
+	return true
+}
+
+// cut moves x from its parent's children to the root list
+func (h *FibonacciHeap[T]) cut(x *fibNode[T]) {
+	parent := x.parent
+	if x.right == x {
+		parent.child = nil
+	} else {
+		if parent.child == x {
+			parent.child = x.right
+		}
+		unlink(x)
+	}
+	parent.degree--
+	x.parent = nil
+	x.mark = false
+	h.addRoot(x)
+}
+
+// validate checks the circular links, parent pointers, degrees, heap order, that
+// min is the least root and the size
+func (h *FibonacciHeap[T]) validate() error {
+	count := 0
+	var checkList func(first, parent *fibNode[T]) (int, error)
+	checkList = func(first, parent *fibNode[T]) (int, error) {
+		length := 0
+		for n := first; ; {
+			length++
+			count++
+			if n.right.left != n || n.left.right != n {
+				return 0, fmt.Errorf("broken sibling links at %v", n.value)
+			}
+			if n.parent != parent {
+				return 0, fmt.Errorf("node %v has the wrong parent", n.value)
+			}
+			if parent != nil && h.less(n.value, parent.value) {
+				return 0, fmt.Errorf("child %v is less than its parent %v", n.value, parent.value)
+			}
+			if parent == nil && h.less(n.value, h.min.value) {
+				return 0, fmt.Errorf("root %v is less than min %v", n.value, h.min.value)
+			}
+			if n.child != nil {
+				children, err := checkList(n.child, n)
+				if err != nil {
+					return 0, err
+				}
+				if children != n.degree {
+					return 0, fmt.Errorf("node %v has degree %d but %d children", n.value, n.degree, children)
+				}
+			} else if n.degree != 0 {
+				return 0, fmt.Errorf("node %v has degree %d but no children", n.value, n.degree)
+			}
+			n = n.right
+			if n == first {
+				return length, nil
+			}
+		}
+	}
+	if h.min != nil {
+		if _, err := checkList(h.min, nil); err != nil {
+			return err
+		}
+	}
+	if count != h.size {
+		return fmt.Errorf("size is %d but found %d nodes", h.size, count)
+	}
+	return nil
+}
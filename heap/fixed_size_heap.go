@@ -1,10 +1,24 @@
 package heap
 
 import (
+	"cmp"
 	"container/heap"
+	"iter"
+	"slices"
 )
 
-// FixedSizeHeap is a min-heap that maintains only the smallest 'size' elements added to it.
+// TopKMode says which end of the ordering a FixedSizeHeap keeps
+type TopKMode int
+
+const (
+	// KeepLargest keeps the k greatest elements seen
+	KeepLargest TopKMode = iota
+	// KeepSmallest keeps the k least elements seen
+	KeepSmallest
+)
+
+// FixedSizeHeap keeps the best 'size' elements added to it, where best means the
+// largest or the smallest under a less function depending on its TopKMode.
 //
 // HEAP STRUCTURE:
 // The heap uses an array (data) to store elements in a binary tree structure.
@@ -13,9 +27,11 @@ import (
 //   - Right child is at index 2*i + 2
 //   - Parent is at index (i-1)/2
 //
-// HEAP PROPERTY (Min-Heap):
-// Every parent node is smaller than its children. The array is NOT sorted.
-// Example: for data = [5, 10, 15, 20, 25], the structure is:
+// HEAP PROPERTY:
+// The root is always the worst element kept - the one to evict next. So when
+// keeping the largest it is a min-heap: every parent is smaller than its children.
+// The array is NOT sorted.
+// Example: keeping the 5 largest with data = [5, 10, 15, 20, 25], the structure is:
 //
 //	     5 (root, index 0)
 //	    / \
@@ -23,103 +39,135 @@ import (
 //	 / \
 //	20 25
 //
+// When keeping the smallest it is a max-heap, with the largest kept element at
+// the root.
+//
 // OPERATIONS:
-// - Push: Appends to end, then "bubbles up" to maintain heap property
-// - Pop: Removes smallest (root), moves last element to root, then "bubbles down"
-// - The container/heap package handles the bubbling automatically via Less() and Swap()
+// - Add (not full): Appends to end, then "bubbles up" to maintain heap property
+// - Add (full): Replaces the root if the new element beats it, then "bubbles down"
+// - container/heap does the bubbling via heapData, ordered worst element first
 //
 // SIZE LIMITATION:
-// When the heap exceeds 'size', the smallest element is removed, keeping only the
-// 'size' largest elements. This makes it memory-efficient for top-k problems.
-type FixedSizeHeap struct {
+// The heap never holds more than 'size' elements, so finding the top k of a
+// stream of n elements takes O(n log k) time and O(k) memory.
+//
+// Add is the only way in: the heap.Interface methods live on data, not on
+// FixedSizeHeap, so heap.Push cannot grow it past size.
+type FixedSizeHeap[T any] struct {
 	size int
-	data []int
+	mode TopKMode
+	less func(a, b T) bool
+	data heapData[T]
 }
 
-func NewFixedSizeHeap(size int) *FixedSizeHeap {
-	return &FixedSizeHeap{
-		size: size,
-		data: []int{},
-	}
+// NewFixedSizeHeap returns a heap keeping the 'size' largest ints
+func NewFixedSizeHeap(size int) *FixedSizeHeap[int] {
+	return NewFixedSizeHeapFunc(size, KeepLargest, cmp.Less[int])
 }
 
-func (h *FixedSizeHeap) Add(value int) {
-	heap.Push(h, value)
+// NewTopK returns a heap keeping the 'size' largest or smallest values of an
+// ordered type
+func NewTopK[T cmp.Ordered](size int, mode TopKMode) *FixedSizeHeap[T] {
+	return NewFixedSizeHeapFunc(size, mode, cmp.Less[T])
 }
 
-func (h *FixedSizeHeap) Len() int {
-	return len(h.data)
+// NewFixedSizeHeapFunc returns a heap keeping the 'size' largest or smallest
+// elements under less
+func NewFixedSizeHeapFunc[T any](size int, mode TopKMode, less func(a, b T) bool) *FixedSizeHeap[T] {
+	h := &FixedSizeHeap[T]{size: size, mode: mode, less: less}
+	// The root must be the element evicted first, the one every other beats
+	h.data = heapData[T]{items: []T{}, less: func(a, b T) bool { return h.better(b, a) }}
+	return h
 }
 
-func (h *FixedSizeHeap) Less(i, j int) bool {
-	// Less defines the heap property: returns true if data[i] < data[j]
-	// For a min-heap, this keeps smaller values at the top
-	// The heap package uses this to decide how to bubble elements up/down
-	return h.data[i] < h.data[j]
-}
-
-func (h *FixedSizeHeap) Swap(i, j int) {
-	// Swap exchanges two elements in the array
-	// Called by the heap package during bubble-up and bubble-down operations
-	h.data[i], h.data[j] = h.data[j], h.data[i]
-}
-
-func (h *FixedSizeHeap) Push(x any) {
-	// Push appends the new value to the end of the array
-	// The heap package then calls Swap() and Less() to bubble it up to its correct position
-	h.data = append(h.data, x.(int))
-	// If we exceed the fixed size, remove the smallest element (which bubbles to the top)
-	// This keeps only the 'size' largest elements in memory
-	if len(h.data) > h.size {
-		heap.Pop(h)
+// better reports whether a should be kept in preference to b
+func (h *FixedSizeHeap[T]) better(a, b T) bool {
+	if h.mode == KeepSmallest {
+		return h.less(a, b)
 	}
+	return h.less(b, a)
 }
 
-func (h *FixedSizeHeap) Pop() any {
-	// Pop removes and returns the smallest element (the root)
-	// The heap package moves the last element to the root and calls Swap() and Less()
-	// to bubble it down to its correct position
-	old := h.data
-	n := len(old)
-	x := old[n-1]         // Get the last element
-	h.data = old[0 : n-1] // Remove it from the array
-	return x
+// Add offers value to the heap in O(log k)
+func (h *FixedSizeHeap[T]) Add(value T) {
+	if h.size <= 0 {
+		return
+	}
+	if h.data.Len() < h.size {
+		heap.Push(&h.data, value)
+		return
+	}
+	// Full: the root is the worst element kept, so value only gets in by beating it
+	if h.better(value, h.data.items[0]) {
+		h.data.items[0] = value
+		heap.Fix(&h.data, 0)
+	}
 }
 
-// HeapItem represents an element from one of the sorted arrays being merged.
-// It tracks the value and its position (which array and which index within that array).
-type HeapItem struct {
-	value        int
-	arrayIndex   int
-	elementIndex int
+// Peek returns the worst element still kept - the k'th largest or smallest seen
+// once the heap is full
+func (h *FixedSizeHeap[T]) Peek() (v T, ok bool) {
+	if h.data.Len() == 0 {
+		return v, false
+	}
+	return h.data.items[0], true
 }
 
-// MinHeap is a min-heap implementation for merging sorted arrays.
-// It implements the heap.Interface required by the container/heap package.
-type MinHeap []HeapItem
-
-func (h MinHeap) Len() int {
-	return len(h)
+// Sorted returns the elements kept in ascending order under less, whichever
+// mode the heap is in, without modifying the heap
+//
+// Time Complexity: O(k log k)
+func (h *FixedSizeHeap[T]) Sorted() []T {
+	result := slices.Clone(h.data.items)
+	slices.SortFunc(result, func(a, b T) int {
+		switch {
+		case h.less(a, b):
+			return -1
+		case h.less(b, a):
+			return 1
+		default:
+			return 0
+		}
+	})
+	return result
 }
 
-func (h MinHeap) Less(i, j int) bool {
-	return h[i].value < h[j].value
+func (h *FixedSizeHeap[T]) Len() int {
+	return h.data.Len()
 }
 
-func (h MinHeap) Swap(i, j int) {
-	h[i], h[j] = h[j], h[i]
+// SmallestK returns the k smallest values of a stream in ascending order,
+// holding at most k of them in memory at a time
+//
+// Time Complexity: O(n log k)
+// Space Complexity: O(k)
+func SmallestK[T cmp.Ordered](values iter.Seq[T], k int) []T {
+	h := NewTopK[T](k, KeepSmallest)
+	for v := range values {
+		h.Add(v)
+	}
+	return h.Sorted()
 }
 
-func (h *MinHeap) Push(x any) {
-	*h = append(*h, x.(HeapItem))
+// LargestK returns the k largest values of a stream in ascending order,
+// holding at most k of them in memory at a time
+//
+// Time Complexity: O(n log k)
+// Space Complexity: O(k)
+func LargestK[T cmp.Ordered](values iter.Seq[T], k int) []T {
+	h := NewTopK[T](k, KeepLargest)
+	for v := range values {
+		h.Add(v)
+	}
+	return h.Sorted()
 }
 
-func (h *MinHeap) Pop() any {
-	old := *h
-	n := len(old)
-	item := old[n-1]
-	*h = old[0 : n-1]
-	return item
+// HeapItem represents an element from one of the sorted arrays being merged.
+// It tracks the value and its position (which array and which index within that array).
+type HeapItem[T any] struct {
+	value        T
+	arrayIndex   int
+	elementIndex int
 }
 
 // MergeSortedArrays merges multiple sorted arrays into a single sorted array.
@@ -134,26 +182,24 @@ func (h *MinHeap) Pop() any {
 // 4. Repeat until the heap is empty
 //
 // EXAMPLE: MergeSortedArrays([][]int{{1,3},{2},{4,5}}) returns [1,2,3,4,5]
-func MergeSortedArrays(arrays [][]int) []int {
-	h := &MinHeap{}
-	heap.Init(h)
+func MergeSortedArrays[T cmp.Ordered](arrays [][]T) []T {
+	h := New(func(a, b HeapItem[T]) bool { return a.value < b.value })
 
 	// Push the first element of each array into the heap
 	for i, arr := range arrays {
 		if len(arr) > 0 {
-			heap.Push(h, HeapItem{value: arr[0], arrayIndex: i, elementIndex: 0})
+			h.Push(HeapItem[T]{value: arr[0], arrayIndex: i, elementIndex: 0})
 		}
 	}
 
-	result := make([]int, 0)
-	for h.Len() > 0 {
-		item := heap.Pop(h).(HeapItem)
+	result := make([]T, 0)
+	for item := range h.Drain() {
 		result = append(result, item.value)
 
 		// If there are more elements in the same array, push the next one into the heap
 		if item.elementIndex+1 < len(arrays[item.arrayIndex]) {
 			nextValue := arrays[item.arrayIndex][item.elementIndex+1]
-			heap.Push(h, HeapItem{value: nextValue, arrayIndex: item.arrayIndex, elementIndex: item.elementIndex + 1})
+			h.Push(HeapItem[T]{value: nextValue, arrayIndex: item.arrayIndex, elementIndex: item.elementIndex + 1})
 		}
 	}
 
@@ -171,61 +217,30 @@ func MergeSortedArrays(arrays [][]int) []int {
  * Space Complexity: O(k)
  */
 // EXAMPLE: SortKSortedArray([]int{3,2,1,5,4}, 2) returns [1,2,3,4,5]
-func SortKSortedArray(arr []int, k int) []int {
-	h := &MinHeap{}
-	heap.Init(h)
+func SortKSortedArray[T cmp.Ordered](arr []T, k int) []T {
+	h := NewMin[T]()
 
-	result := make([]int, 0, len(arr))
+	result := make([]T, 0, len(arr))
 
 	// Add the first k+1 elements to the heap
 	for i := 0; i <= k && i < len(arr); i++ {
-		heap.Push(h, HeapItem{value: arr[i]})
+		h.Push(arr[i])
 	}
 
 	for i := k + 1; i < len(arr); i++ {
-		minItem := heap.Pop(h).(HeapItem)
-		result = append(result, minItem.value)
-		heap.Push(h, HeapItem{value: arr[i]})
+		minValue, _ := h.Pop()
+		result = append(result, minValue)
+		h.Push(arr[i])
 	}
 
 	// Drain the remaining elements from the heap
-	for h.Len() > 0 {
-		minItem := heap.Pop(h).(HeapItem)
-		result = append(result, minItem.value)
+	for v := range h.Drain() {
+		result = append(result, v)
 	}
 
 	return result
 }
 
-// MaxHeap is a max-heap implementation for finding the k closest stars.
-// It implements the heap.Interface required by the container/heap package.
-type MaxHeap []Star
-
-func (h MaxHeap) Len() int {
-	return len(h)
-}
-
-func (h MaxHeap) Less(i, j int) bool {
-	// For a max-heap, we want the farther star to be "less" so it bubbles to the top
-	return h[i].Distance() > h[j].Distance()
-}
-
-func (h MaxHeap) Swap(i, j int) {
-	h[i], h[j] = h[j], h[i]
-}
-
-func (h *MaxHeap) Push(x any) {
-	*h = append(*h, x.(Star))
-}
-
-func (h *MaxHeap) Pop() any {
-	old := *h
-	n := len(old)
-	star := old[n-1]
-	*h = old[0 : n-1]
-	return star
-}
-
 type Star struct {
 	X, Y, Z float64
 }
@@ -236,34 +251,37 @@ func (s Star) Distance() float64 {
 
 /**
  * EPIJ 10.4: Compute k Closest stars. Use a Max-heap - always removing the max
- * as we go.
+ * as we go. Works for any star type with an ordered Distance, closest first.
  * Time Complexity: O(n log k)
  * Space Complexity: O(k)
  */
-func KClosestStars(stars []Star, k int) []Star {
-	h := &MaxHeap{}
-	heap.Init(h)
+func KClosestStars[S interface{ Distance() D }, D cmp.Ordered](stars []S, k int) []S {
+	h := New(func(a, b S) bool { return a.Distance() > b.Distance() })
 
 	for _, star := range stars {
-		heap.Push(h, star)
+		h.Push(star)
 		if h.Len() > k {
-			heap.Pop(h) // Remove the farthest star
+			h.Pop() // Remove the farthest star
 		}
 	}
 
-	result := make([]Star, h.Len())
-	for i := 0; i < len(result); i++ {
-		result[i] = heap.Pop(h).(Star)
-	}
-
-	// Reverse the result since heap.Pop returns elements in reverse order
-	for i, j := 0, len(result)-1; i < j; i, j = i+1, j-1 {
-		result[i], result[j] = result[j], result[i]
+	// The max-heap pops the farthest first, so fill the result from the back
+	result := make([]S, h.Len())
+	for i := len(result) - 1; i >= 0; i-- {
+		result[i], _ = h.Pop()
 	}
 
 	return result
 }
 
+// Number is any integer or floating-point type, which OnlineMedian needs to be
+// able to average two values
+type Number interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 |
+		~float32 | ~float64
+}
+
 /**
  * EPIJ 10.5: Compute the Median of online data.
  * The median of collection divides the collection into two equal parts. When a new element is
@@ -273,65 +291,35 @@ func KClosestStars(stars []Star, k int) []Star {
  * the heaps balanced in size.
  * Time Complexity: O(log n) per entry corresponding to insertion and extraction from heap
  */
-
-// MaxHeapItem is a max-heap implementation for HeapItem (used in online median).
-// It implements the heap.Interface required by the container/heap package.
-type MaxHeapItem []HeapItem
-
-func (h MaxHeapItem) Len() int {
-	return len(h)
-}
-
-func (h MaxHeapItem) Less(i, j int) bool {
-	// For a max-heap, reverse the comparison
-	return h[i].value > h[j].value
-}
-
-func (h MaxHeapItem) Swap(i, j int) {
-	h[i], h[j] = h[j], h[i]
-}
-
-func (h *MaxHeapItem) Push(x any) {
-	*h = append(*h, x.(HeapItem))
-}
-
-func (h *MaxHeapItem) Pop() any {
-	old := *h
-	n := len(old)
-	item := old[n-1]
-	*h = old[0 : n-1]
-	return item
-}
-
-func OnlineMedian(stream []int) []float64 {
-	smallerHalf := &MaxHeapItem{}
-	largerHalf := &MinHeap{}
-	heap.Init(smallerHalf)
-	heap.Init(largerHalf)
+func OnlineMedian[T Number](stream []T) []float64 {
+	smallerHalf := NewMax[T]()
+	largerHalf := NewMin[T]()
 
 	medians := make([]float64, len(stream))
 
 	for i, num := range stream {
-		if smallerHalf.Len() == 0 || num <= (*smallerHalf)[0].value {
-			heap.Push(smallerHalf, HeapItem{value: num})
+		if top, ok := smallerHalf.Peek(); !ok || num <= top {
+			smallerHalf.Push(num)
 		} else {
-			heap.Push(largerHalf, HeapItem{value: num})
+			largerHalf.Push(num)
 		}
 
 		// Balance the heaps
 		if smallerHalf.Len() > largerHalf.Len()+1 {
-			item := heap.Pop(smallerHalf).(HeapItem)
-			heap.Push(largerHalf, item)
+			v, _ := smallerHalf.Pop()
+			largerHalf.Push(v)
 		} else if largerHalf.Len() > smallerHalf.Len() {
-			item := heap.Pop(largerHalf).(HeapItem)
-			heap.Push(smallerHalf, item)
+			v, _ := largerHalf.Pop()
+			smallerHalf.Push(v)
 		}
 
-		// Calculate median
+		// Calculate median, converting before adding so the sum cannot overflow T
+		lower, _ := smallerHalf.Peek()
 		if smallerHalf.Len() > largerHalf.Len() {
-			medians[i] = float64((*smallerHalf)[0].value)
+			medians[i] = float64(lower)
 		} else {
-			medians[i] = float64((*smallerHalf)[0].value+(*largerHalf)[0].value) / 2.0
+			upper, _ := largerHalf.Peek()
+			medians[i] = (float64(lower) + float64(upper)) / 2.0
 		}
 	}
 
@@ -339,81 +327,21 @@ func OnlineMedian(stream []int) []float64 {
 }
 
 /**
- * Finds the N smallest but it's very space inefficient.
- * Need a FixedSizePriorityQueue implementation that only keeps "n" objects.
- * How can I fix this it is returning N Largest instead of N Smallest? I think I need to use a MaxHeap instead of a MinHeap and pop the max when I exceed the size.
- * Time Complexity: O(n log n) due to sorting the entire array, which is inefficient for large n.
- * Space Complexity: O(n) due to storing the entire array in the heap, which is inefficient for large n.
+ * Finds the N smallest values, in ascending order, by streaming them through a
+ * FixedSizeHeap that keeps the smallest - so only n values are ever held.
+ * Time Complexity: O(len(arr) log n)
+ * Space Complexity: O(n)
  */
-type MinHeapInt []int
-
-func (h MinHeapInt) Len() int           { return len(h) }
-func (h MinHeapInt) Less(i, j int) bool { return h[i] < h[j] }
-func (h MinHeapInt) Swap(i, j int)      { h[i], h[j] = h[j], h[i] }
-
-func (h *MinHeapInt) Push(x any) {
-	*h = append(*h, x.(int))
-}
-
-func (h *MinHeapInt) Pop() any {
-	old := *h
-	n := len(old)
-	x := old[n-1]
-	*h = old[:n-1]
-	return x
-}
 func getNSmallestHeap(arr []int, n int) []int {
-	if n <= 0 {
-		return []int{}
-	}
-	if n >= len(arr) {
-		n = len(arr)
-	}
-
-	h := MinHeapInt(make([]int, len(arr)))
-	copy(h, arr)
-	heap.Init(&h)
-
-	result := make([]int, n)
-	for i := range result {
-		result[i] = heap.Pop(&h).(int)
-	}
-	return result
-}
-
-type MaxHeapInt []int
-
-func (h MaxHeapInt) Len() int           { return len(h) }
-func (h MaxHeapInt) Less(i, j int) bool { return h[i] > h[j] }
-func (h MaxHeapInt) Swap(i, j int)      { h[i], h[j] = h[j], h[i] }
-
-func (h *MaxHeapInt) Push(x any) {
-	*h = append(*h, x.(int))
-}
-
-func (h *MaxHeapInt) Pop() any {
-	old := *h
-	n := len(old)
-	x := old[n-1]
-	*h = old[:n-1]
-	return x
+	return SmallestK(slices.Values(arr), n)
 }
 
+/**
+ * Finds the N largest values, in ascending order, the same way with a
+ * FixedSizeHeap that keeps the largest.
+ * Time Complexity: O(len(arr) log n)
+ * Space Complexity: O(n)
+ */
 func getNLargestHeap(arr []int, n int) []int {
-	if n <= 0 {
-		return []int{}
-	}
-	if n >= len(arr) {
-		n = len(arr)
-	}
-
-	h := MaxHeapInt(make([]int, len(arr)))
-	copy(h, arr)
-	heap.Init(&h)
-
-	result := make([]int, n)
-	for i := range result {
-		result[(n-1)-i] = heap.Pop(&h).(int)
-	}
-	return result
+	return LargestK(slices.Values(arr), n)
 }
@@ -1,6 +1,7 @@
 package heap
 
 import (
+	"container/heap"
 	"sort"
 	"testing"
 
@@ -23,8 +24,8 @@ func TestAddMultipleElements(t *testing.T) {
 
 	assert.Equal(t, 5, h.Len())
 	// Just verify all elements are present, not their specific order
-	result := make([]int, len(h.data))
-	copy(result, h.data)
+	result := make([]int, len(h.data.items))
+	copy(result, h.data.items)
 	sort.Ints(result)
 	expected := []int{5, 10, 15, 20, 25}
 	assert.Equal(t, expected, result)
@@ -38,8 +39,8 @@ func TestAddExceedingSize(t *testing.T) {
 	h.Add(15) // This should cause the smallest (5) to be removed
 
 	assert.Equal(t, 3, h.Len())
-	result := make([]int, len(h.data))
-	copy(result, h.data)
+	result := make([]int, len(h.data.items))
+	copy(result, h.data.items)
 	sort.Ints(result)
 	expected := []int{10, 15, 20} // 5 should be removed
 	assert.Equal(t, expected, result)
@@ -219,3 +220,109 @@ func TestNLargestNZero(t *testing.T) {
 	expected := []int{}
 	assert.Equal(t, expected, result)
 }
+
+func TestMergeSortedArraysStrings(t *testing.T) {
+	arrays := [][]string{{"ant", "eel"}, {"bee", "cat", "fox"}, {"dog"}}
+	result := MergeSortedArrays(arrays)
+	expected := []string{"ant", "bee", "cat", "dog", "eel", "fox"}
+	assert.Equal(t, expected, result)
+}
+
+func TestSortKSortFloats(t *testing.T) {
+	arr := []float64{2.5, 1.5, 3.5, 0.5}
+	result := SortKSortedArray(arr, 3)
+	expected := []float64{0.5, 1.5, 2.5, 3.5}
+	assert.Equal(t, expected, result)
+}
+
+// word is a star whose distance is its length
+type word string
+
+func (w word) Distance() int { return len(w) }
+
+func TestKClosestStarsGeneric(t *testing.T) {
+	words := []word{"kitten", "a", "hippopotamus", "cat", "ox"}
+	result := KClosestStars(words, 3)
+	expected := []word{"a", "ox", "cat"}
+	assert.Equal(t, expected, result)
+
+	assert.Empty(t, KClosestStars(words, 0))
+	assert.Len(t, KClosestStars(words, 10), 5)
+}
+
+func TestOnlineMedianFloats(t *testing.T) {
+	result := OnlineMedian([]float64{1.5, 0.5, 2})
+	expected := []float64{1.5, 1, 1.5}
+	assert.Equal(t, expected, result)
+}
+
+func TestOnlineMedianDoesNotOverflow(t *testing.T) {
+	result := OnlineMedian([]int8{100, 120})
+	expected := []float64{100, 110}
+	assert.Equal(t, expected, result)
+}
+
+func TestFixedSizeHeapKeepSmallest(t *testing.T) {
+	h := NewTopK[int](3, KeepSmallest)
+	for _, v := range []int{10, 20, 5, 15, 1, 30} {
+		h.Add(v)
+	}
+	assert.Equal(t, 3, h.Len())
+	assert.Equal(t, []int{1, 5, 10}, h.Sorted())
+
+	// The root is the largest value kept, the next one to go
+	top, ok := h.Peek()
+	assert.True(t, ok)
+	assert.Equal(t, 10, top)
+}
+
+func TestFixedSizeHeapKeepLargestSorted(t *testing.T) {
+	h := NewFixedSizeHeap(3)
+	_, ok := h.Peek()
+	assert.False(t, ok)
+	for _, v := range []int{10, 20, 5, 15, 1, 30} {
+		h.Add(v)
+	}
+	assert.Equal(t, []int{15, 20, 30}, h.Sorted())
+	top, _ := h.Peek()
+	assert.Equal(t, 15, top)
+	// Sorted leaves the heap intact
+	assert.Equal(t, 3, h.Len())
+	h.Add(25)
+	assert.Equal(t, []int{20, 25, 30}, h.Sorted())
+}
+
+func TestFixedSizeHeapComparator(t *testing.T) {
+	// Keep the three shortest words, ordering by length
+	h := NewFixedSizeHeapFunc(3, KeepSmallest, func(a, b string) bool { return len(a) < len(b) })
+	for _, w := range []string{"elephant", "ox", "giraffe", "cat", "a", "horse"} {
+		h.Add(w)
+	}
+	assert.Equal(t, []string{"a", "ox", "cat"}, h.Sorted())
+}
+
+func TestFixedSizeHeapZeroSize(t *testing.T) {
+	h := NewTopK[int](0, KeepLargest)
+	h.Add(1)
+	assert.Equal(t, 0, h.Len())
+	assert.Equal(t, []int{}, h.Sorted())
+}
+
+func TestSmallestAndLargestKOfStream(t *testing.T) {
+	stream := func(yield func(int) bool) {
+		for i := 1000; i > 0; i-- {
+			if !yield(i) {
+				return
+			}
+		}
+	}
+	assert.Equal(t, []int{1, 2, 3}, SmallestK(stream, 3))
+	assert.Equal(t, []int{998, 999, 1000}, LargestK(stream, 3))
+}
+
+func TestFixedSizeHeapIsNotAHeapInterface(t *testing.T) {
+	// Add is the only way to insert, so the size limit cannot be bypassed
+	var h any = NewFixedSizeHeap(3)
+	_, ok := h.(heap.Interface)
+	assert.False(t, ok)
+}
@@ -0,0 +1,117 @@
+package heap
+
+import (
+	"cmp"
+	"container/heap"
+	"iter"
+)
+
+// Heap is a binary heap of T ordered by a less function: Pop always returns an
+// element e for which less(x, e) is false for every other element x, so a less
+// of a < b gives a min-heap and a > b a max-heap. It is backed by container/heap,
+// which does the bubbling up and down through the heap.Interface methods of
+// heapData. The zero value is not usable; create one with New, NewMin, NewMax
+// or NewFromSlice.
+type Heap[T any] struct {
+	data heapData[T]
+}
+
+// heapData adapts a slice and a less function to heap.Interface
+type heapData[T any] struct {
+	items []T
+	less  func(a, b T) bool
+}
+
+func (h *heapData[T]) Len() int           { return len(h.items) }
+func (h *heapData[T]) Less(i, j int) bool { return h.less(h.items[i], h.items[j]) }
+func (h *heapData[T]) Swap(i, j int)      { h.items[i], h.items[j] = h.items[j], h.items[i] }
+
+func (h *heapData[T]) Push(x any) {
+	h.items = append(h.items, x.(T))
+}
+
+func (h *heapData[T]) Pop() any {
+	old := h.items
+	n := len(old)
+	x := old[n-1]
+	var zero T
+	old[n-1] = zero // let the garbage collector reclaim popped pointers
+	h.items = old[:n-1]
+	return x
+}
+
+// New returns an empty heap ordered by less
+func New[T any](less func(a, b T) bool) *Heap[T] {
+	return &Heap[T]{data: heapData[T]{less: less}}
+}
+
+// NewMin returns an empty heap that pops the smallest element first
+func NewMin[T cmp.Ordered]() *Heap[T] {
+	return New(cmp.Less[T])
+}
+
+// NewMax returns an empty heap that pops the largest element first
+func NewMax[T cmp.Ordered]() *Heap[T] {
+	return New(func(a, b T) bool { return cmp.Less(b, a) })
+}
+
+// NewFromSlice heapifies values in place and returns a heap that owns them; the
+// caller must not use the slice afterwards.
+//
+// Time Complexity: O(n), cheaper than n pushes at O(n log n)
+func NewFromSlice[T any](values []T, less func(a, b T) bool) *Heap[T] {
+	h := &Heap[T]{data: heapData[T]{items: values, less: less}}
+	heap.Init(&h.data)
+	return h
+}
+
+func (h *Heap[T]) Len() int {
+	return h.data.Len()
+}
+
+// Push adds v in O(log n)
+func (h *Heap[T]) Push(v T) {
+	heap.Push(&h.data, v)
+}
+
+// Pop removes and returns the top element in O(log n); ok is false if the heap
+// is empty
+func (h *Heap[T]) Pop() (v T, ok bool) {
+	if h.Len() == 0 {
+		return v, false
+	}
+	return heap.Pop(&h.data).(T), true
+}
+
+// Peek returns the top element without removing it
+func (h *Heap[T]) Peek() (v T, ok bool) {
+	if h.Len() == 0 {
+		return v, false
+	}
+	return h.data.items[0], true
+}
+
+// All yields every element without removing any, in the heap's internal array
+// order rather than sorted order
+func (h *Heap[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, v := range h.data.items {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Drain pops and yields elements in priority order until the heap is empty or
+// the loop stops early, in which case the rest stay in the heap
+func (h *Heap[T]) Drain() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for h.Len() > 0 {
+			v, _ := h.Pop()
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,110 @@
+package heap
+
+import (
+	"math/rand"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMinHeap(t *testing.T) {
+	h := NewMin[int]()
+	_, ok := h.Pop()
+	assert.False(t, ok)
+	_, ok = h.Peek()
+	assert.False(t, ok)
+
+	for _, v := range []int{5, 3, 8, 1, 9, 1} {
+		h.Push(v)
+	}
+	assert.Equal(t, 6, h.Len())
+	top, ok := h.Peek()
+	assert.True(t, ok)
+	assert.Equal(t, 1, top)
+	assert.Equal(t, 6, h.Len())
+
+	var got []int
+	for v := range h.Drain() {
+		got = append(got, v)
+	}
+	assert.Equal(t, []int{1, 1, 3, 5, 8, 9}, got)
+	assert.Equal(t, 0, h.Len())
+}
+
+func TestMaxHeap(t *testing.T) {
+	h := NewMax[string]()
+	for _, v := range []string{"pear", "apple", "quince", "fig"} {
+		h.Push(v)
+	}
+	assert.Equal(t, []string{"quince", "pear", "fig", "apple"}, slices.Collect(h.Drain()))
+}
+
+func TestHeapWithComparator(t *testing.T) {
+	type task struct {
+		name     string
+		priority int
+	}
+	h := New(func(a, b task) bool { return a.priority > b.priority })
+	h.Push(task{"low", 1})
+	h.Push(task{"high", 10})
+	h.Push(task{"mid", 5})
+
+	v, ok := h.Pop()
+	assert.True(t, ok)
+	assert.Equal(t, "high", v.name)
+	v, _ = h.Pop()
+	assert.Equal(t, "mid", v.name)
+}
+
+func TestNewFromSlice(t *testing.T) {
+	values := []int{9, 4, 7, 1, 8, 2}
+	h := NewFromSlice(values, func(a, b int) bool { return a < b })
+	assert.Equal(t, 6, h.Len())
+
+	// All visits every element without removing any
+	assert.ElementsMatch(t, []int{1, 2, 4, 7, 8, 9}, slices.Collect(h.All()))
+	assert.Equal(t, 6, h.Len())
+
+	h.Push(3)
+	assert.Equal(t, []int{1, 2, 3, 4, 7, 8, 9}, slices.Collect(h.Drain()))
+
+	empty := NewFromSlice[int](nil, func(a, b int) bool { return a < b })
+	assert.Equal(t, 0, empty.Len())
+}
+
+func TestDrainStopsEarly(t *testing.T) {
+	h := NewMin[int]()
+	for i := range 5 {
+		h.Push(i)
+	}
+	for v := range h.Drain() {
+		if v == 1 {
+			break
+		}
+	}
+	assert.Equal(t, 3, h.Len())
+	top, _ := h.Peek()
+	assert.Equal(t, 2, top)
+}
+
+func TestHeapAgainstSort(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	h := NewMin[int]()
+	var model []int
+	for range 2000 {
+		if len(model) == 0 || r.Intn(3) > 0 {
+			v := r.Intn(100)
+			h.Push(v)
+			model = append(model, v)
+			continue
+		}
+		slices.Sort(model)
+		v, ok := h.Pop()
+		assert.True(t, ok)
+		assert.Equal(t, model[0], v)
+		model = model[1:]
+	}
+	slices.Sort(model)
+	assert.Equal(t, model, slices.Collect(h.Drain()))
+}
@@ -0,0 +1,140 @@
+package heap
+
+import (
+	"cmp"
+	"container/heap"
+)
+
+// Handle identifies one element of an IndexedPriorityQueue. It records the
+// element's current position in the heap array, which is what lets the queue
+// find the element again in O(1) to change or remove it.
+type Handle[K, P any] struct {
+	key      K
+	priority P
+	index    int // -1 once the element has left the queue
+	queue    *IndexedPriorityQueue[K, P]
+}
+
+func (h *Handle[K, P]) Key() K      { return h.key }
+func (h *Handle[K, P]) Priority() P { return h.priority }
+
+// IndexedPriorityQueue is a priority queue whose elements can have their
+// priority changed or be removed after they were pushed, through the Handle
+// returned by Push. This is the decrease-key operation Dijkstra's and Prim's
+// algorithms need, and lets a scheduler reprioritise or cancel queued work.
+// Pop returns the element with the least priority under less; the same key may
+// be pushed more than once and each push is a separate element.
+type IndexedPriorityQueue[K, P any] struct {
+	data indexedData[K, P]
+}
+
+// indexedData adapts the handles to heap.Interface, keeping each handle's index
+// in step with its position as container/heap moves it
+type indexedData[K, P any] struct {
+	items []*Handle[K, P]
+	less  func(a, b P) bool
+}
+
+func (d *indexedData[K, P]) Len() int { return len(d.items) }
+
+func (d *indexedData[K, P]) Less(i, j int) bool {
+	return d.less(d.items[i].priority, d.items[j].priority)
+}
+
+func (d *indexedData[K, P]) Swap(i, j int) {
+	d.items[i], d.items[j] = d.items[j], d.items[i]
+	d.items[i].index = i
+	d.items[j].index = j
+}
+
+func (d *indexedData[K, P]) Push(x any) {
+	item := x.(*Handle[K, P])
+	item.index = len(d.items)
+	d.items = append(d.items, item)
+}
+
+func (d *indexedData[K, P]) Pop() any {
+	old := d.items
+	n := len(old)
+	item := old[n-1]
+	old[n-1] = nil
+	item.index = -1
+	d.items = old[:n-1]
+	return item
+}
+
+// NewIndexedPriorityQueue returns an empty queue ordered by less
+func NewIndexedPriorityQueue[K, P any](less func(a, b P) bool) *IndexedPriorityQueue[K, P] {
+	return &IndexedPriorityQueue[K, P]{data: indexedData[K, P]{less: less}}
+}
+
+// NewIndexedMinPriorityQueue returns an empty queue that pops the smallest
+// priority first
+func NewIndexedMinPriorityQueue[K any, P cmp.Ordered]() *IndexedPriorityQueue[K, P] {
+	return NewIndexedPriorityQueue[K](cmp.Less[P])
+}
+
+func (q *IndexedPriorityQueue[K, P]) Len() int {
+	return q.data.Len()
+}
+
+// Push adds key with priority and returns its handle
+//
+// Time Complexity: O(log n)
+func (q *IndexedPriorityQueue[K, P]) Push(key K, priority P) *Handle[K, P] {
+	item := &Handle[K, P]{key: key, priority: priority, queue: q}
+	heap.Push(&q.data, item)
+	return item
+}
+
+// Pop removes and returns the element with the least priority
+//
+// Time Complexity: O(log n)
+func (q *IndexedPriorityQueue[K, P]) Pop() (key K, priority P, ok bool) {
+	if q.Len() == 0 {
+		return key, priority, false
+	}
+	item := heap.Pop(&q.data).(*Handle[K, P])
+	return item.key, item.priority, true
+}
+
+// Peek returns the element with the least priority without removing it
+func (q *IndexedPriorityQueue[K, P]) Peek() (key K, priority P, ok bool) {
+	if q.Len() == 0 {
+		return key, priority, false
+	}
+	top := q.data.items[0]
+	return top.key, top.priority, true
+}
+
+// Contains reports whether h is still in this queue, i.e. has been neither
+// popped nor removed
+//
+// Time Complexity: O(1)
+func (q *IndexedPriorityQueue[K, P]) Contains(h *Handle[K, P]) bool {
+	return h != nil && h.queue == q && h.index >= 0
+}
+
+// Update changes the priority of h, moving it up or down as needed. It returns
+// false if h is not in the queue.
+//
+// Time Complexity: O(log n)
+func (q *IndexedPriorityQueue[K, P]) Update(h *Handle[K, P], priority P) bool {
+	if !q.Contains(h) {
+		return false
+	}
+	h.priority = priority
+	heap.Fix(&q.data, h.index)
+	return true
+}
+
+// Remove takes h out of the queue. It returns false if h is not in the queue.
+//
+// Time Complexity: O(log n)
+func (q *IndexedPriorityQueue[K, P]) Remove(h *Handle[K, P]) bool {
+	if !q.Contains(h) {
+		return false
+	}
+	heap.Remove(&q.data, h.index)
+	return true
+}
@@ -0,0 +1,161 @@
+package heap
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIndexedPriorityQueue(t *testing.T) {
+	q := NewIndexedMinPriorityQueue[string, int]()
+	_, _, ok := q.Pop()
+	assert.False(t, ok)
+	_, _, ok = q.Peek()
+	assert.False(t, ok)
+
+	write := q.Push("write report", 5)
+	q.Push("lunch", 3)
+	review := q.Push("review PR", 8)
+	assert.Equal(t, 3, q.Len())
+	assert.Equal(t, "review PR", review.Key())
+	assert.Equal(t, 8, review.Priority())
+
+	// Decrease-key moves the element to the front
+	assert.True(t, q.Update(review, 1))
+	key, priority, ok := q.Peek()
+	assert.True(t, ok)
+	assert.Equal(t, "review PR", key)
+	assert.Equal(t, 1, priority)
+
+	// Increase-key moves it back
+	assert.True(t, q.Update(review, 10))
+	key, _, _ = q.Peek()
+	assert.Equal(t, "lunch", key)
+
+	assert.True(t, q.Contains(write))
+	assert.True(t, q.Remove(write))
+	assert.False(t, q.Contains(write))
+	assert.False(t, q.Remove(write))
+	assert.False(t, q.Update(write, 0))
+
+	key, priority, _ = q.Pop()
+	assert.Equal(t, "lunch", key)
+	assert.Equal(t, 3, priority)
+	key, _, _ = q.Pop()
+	assert.Equal(t, "review PR", key)
+	assert.False(t, q.Contains(review))
+	assert.Equal(t, 0, q.Len())
+}
+
+func TestIndexedPriorityQueueForeignHandle(t *testing.T) {
+	q1 := NewIndexedMinPriorityQueue[string, int]()
+	q2 := NewIndexedMinPriorityQueue[string, int]()
+	h := q1.Push("a", 1)
+	assert.False(t, q2.Contains(h))
+	assert.False(t, q2.Update(h, 0))
+	assert.False(t, q2.Remove(h))
+	assert.False(t, q2.Contains(nil))
+	assert.Equal(t, 1, q1.Len())
+}
+
+func TestIndexedPriorityQueueCustomOrder(t *testing.T) {
+	type deadline struct{ day, hour int }
+	q := NewIndexedPriorityQueue[string](func(a, b deadline) bool {
+		if a.day != b.day {
+			return a.day < b.day
+		}
+		return a.hour < b.hour
+	})
+	q.Push("b", deadline{2, 9})
+	a := q.Push("a", deadline{3, 8})
+	q.Push("c", deadline{2, 17})
+	q.Update(a, deadline{1, 23})
+
+	var order []string
+	for q.Len() > 0 {
+		key, _, _ := q.Pop()
+		order = append(order, key)
+	}
+	assert.Equal(t, []string{"a", "b", "c"}, order)
+}
+
+// Dijkstra with decrease-key: each vertex is in the queue at most once
+func TestIndexedPriorityQueueDijkstra(t *testing.T) {
+	edges := map[int][][2]int{ // vertex -> (target, weight)
+		0: {{1, 4}, {2, 1}},
+		2: {{1, 2}, {3, 7}},
+		1: {{3, 1}},
+	}
+	distance := map[int]int{0: 0}
+	handles := map[int]*Handle[int, int]{}
+	q := NewIndexedMinPriorityQueue[int, int]()
+	handles[0] = q.Push(0, 0)
+
+	for q.Len() > 0 {
+		u, d, _ := q.Pop()
+		for _, e := range edges[u] {
+			v, w := e[0], e[1]
+			if old, seen := distance[v]; !seen || d+w < old {
+				distance[v] = d + w
+				if h, queued := handles[v]; queued && q.Contains(h) {
+					q.Update(h, d+w)
+				} else {
+					handles[v] = q.Push(v, d+w)
+				}
+			}
+		}
+	}
+	assert.Equal(t, map[int]int{0: 0, 1: 3, 2: 1, 3: 4}, distance)
+}
+
+func TestIndexedPriorityQueueAgainstModel(t *testing.T) {
+	r := rand.New(rand.NewSource(3))
+	q := NewIndexedMinPriorityQueue[int, int]()
+	model := map[*Handle[int, int]]int{} // live handle -> priority
+
+	minimum := func() int {
+		best := -1
+		for _, p := range model {
+			if best == -1 || p < best {
+				best = p
+			}
+		}
+		return best
+	}
+	anyHandle := func() *Handle[int, int] {
+		for h := range model {
+			return h
+		}
+		return nil
+	}
+
+	for step := range 5000 {
+		switch op := r.Intn(4); {
+		case op == 0 || len(model) == 0:
+			p := r.Intn(1000)
+			model[q.Push(step, p)] = p
+		case op == 1:
+			h := anyHandle()
+			p := r.Intn(1000)
+			assert.True(t, q.Update(h, p))
+			model[h] = p
+		case op == 2:
+			h := anyHandle()
+			assert.True(t, q.Remove(h))
+			delete(model, h)
+		default:
+			want := minimum()
+			_, p, ok := q.Pop()
+			assert.True(t, ok)
+			assert.Equal(t, want, p)
+			for h, hp := range model {
+				if !q.Contains(h) {
+					assert.Equal(t, p, hp)
+					delete(model, h)
+				}
+			}
+		}
+		assert.Equal(t, len(model), q.Len())
+	}
+}
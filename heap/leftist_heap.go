@@ -0,0 +1,219 @@
+package heap
+
+import "fmt"
+
+type treeNode[T any] struct {
+	value               T
+	left, right, parent *treeNode[T]
+	// rank is the length of the right spine, the shortest path to a missing
+	// child. Only leftist heaps maintain it.
+	rank    int
+	owner   *heapOwner
+	removed bool
+}
+
+func (n *treeNode[T]) Value() T { return n.value }
+
+func rank[T any](n *treeNode[T]) int {
+	if n == nil {
+		return 0
+	}
+	return n.rank
+}
+
+// treeHeap is a heap-ordered binary tree where every operation is a merge of two
+// trees down their right spines. LeftistHeap and SkewHeap differ only in how a
+// merge rebalances afterwards.
+type treeHeap[T any] struct {
+	root  *treeNode[T]
+	size  int
+	less  func(a, b T) bool
+	skew  bool
+	owner ownership
+}
+
+// LeftistHeap keeps every node's left subtree at least as high-ranked as its
+// right, so the right spine - the only path a merge walks - has O(log n) nodes.
+type LeftistHeap[T any] struct {
+	treeHeap[T]
+}
+
+// SkewHeap is the self-adjusting version of LeftistHeap: instead of tracking
+// ranks it swaps the children of every node on the merge path, which keeps right
+// spines short in the amortized sense.
+type SkewHeap[T any] struct {
+	treeHeap[T]
+}
+
+var (
+	_ MergeableHeap[int, *LeftistHeap[int]] = (*LeftistHeap[int])(nil)
+	_ MergeableHeap[int, *SkewHeap[int]]    = (*SkewHeap[int])(nil)
+)
+
+func NewLeftistHeap[T any](less func(a, b T) bool) *LeftistHeap[T] {
+	return &LeftistHeap[T]{treeHeap[T]{less: less}}
+}
+
+func NewSkewHeap[T any](less func(a, b T) bool) *SkewHeap[T] {
+	return &SkewHeap[T]{treeHeap[T]{less: less, skew: true}}
+}
+
+// Meld merges the two trees' right spines in O(log n)
+func (h *LeftistHeap[T]) Meld(other *LeftistHeap[T]) { h.meld(&other.treeHeap) }
+
+// Meld merges the two trees' right spines in amortized O(log n)
+func (h *SkewHeap[T]) Meld(other *SkewHeap[T]) { h.meld(&other.treeHeap) }
+
+func (h *treeHeap[T]) Len() int { return h.size }
+
+// merge walks down the right spines taking the smaller root each time, so the
+// result is the two spines interleaved in order, then rebalances bottom up
+func (h *treeHeap[T]) merge(a, b *treeNode[T]) *treeNode[T] {
+	if a == nil {
+		return b
+	}
+	if b == nil {
+		return a
+	}
+	if h.less(b.value, a.value) {
+		a, b = b, a
+	}
+	a.right = h.merge(a.right, b)
+	a.right.parent = a
+
+	if h.skew {
+		a.left, a.right = a.right, a.left
+	} else {
+		if rank(a.left) < rank(a.right) {
+			a.left, a.right = a.right, a.left
+		}
+		a.rank = rank(a.right) + 1
+	}
+	return a
+}
+
+func (h *treeHeap[T]) setRoot(root *treeNode[T]) {
+	h.root = root
+	if root != nil {
+		root.parent = nil
+	}
+}
+
+func (h *treeHeap[T]) Push(v T) Node[T] {
+	n := &treeNode[T]{value: v, rank: 1, owner: h.owner.token()}
+	h.setRoot(h.merge(h.root, n))
+	h.size++
+	return n
+}
+
+func (h *treeHeap[T]) Peek() (v T, ok bool) {
+	if h.root == nil {
+		return v, false
+	}
+	return h.root.value, true
+}
+
+func (h *treeHeap[T]) Pop() (v T, ok bool) {
+	top := h.root
+	if top == nil {
+		return v, false
+	}
+	h.setRoot(h.merge(top.left, top.right))
+	h.size--
+	top.left, top.right = nil, nil
+	top.removed = true
+	return top.value, true
+}
+
+func (h *treeHeap[T]) meld(other *treeHeap[T]) {
+	if other == h {
+		return
+	}
+	h.setRoot(h.merge(h.root, other.root))
+	h.size += other.size
+	other.root, other.size = nil, 0
+	h.owner.absorb(&other.owner)
+}
+
+/**
+ * DecreaseKey: if the node is still no less than its parent nothing moves.
+ * Otherwise cut its subtree off - it is still heap ordered - and merge it back in
+ * at the root. In a leftist heap the cut can lower the ranks of the ancestors, so
+ * walk up restoring the leftist property until a rank stops changing; only nodes
+ * whose right spine leads to the cut can change, and there are O(log n) of them.
+ */
+func (h *treeHeap[T]) DecreaseKey(n Node[T], v T) bool {
+	node, ok := n.(*treeNode[T])
+	if !ok || node.removed || !h.owner.owns(node.owner) || h.less(node.value, v) {
+		return false
+	}
+	node.value = v
+	parent := node.parent
+	if parent == nil || !h.less(v, parent.value) {
+		return true
+	}
+
+	if parent.left == node {
+		parent.left = nil
+	} else {
+		parent.right = nil
+	}
+	node.parent = nil
+
+	if !h.skew {
+		for q := parent; q != nil; q = q.parent {
+			if rank(q.left) < rank(q.right) {
+				q.left, q.right = q.right, q.left
+			}
+			newRank := rank(q.right) + 1
+			if newRank == q.rank {
+				break
+			}
+			q.rank = newRank
+		}
+	}
+
+	h.setRoot(h.merge(h.root, node))
+	return true
+}
+
+// validate checks heap order, parent pointers, the leftist property and the size
+func (h *treeHeap[T]) validate() error {
+	count := 0
+	var check func(n *treeNode[T]) error
+	check = func(n *treeNode[T]) error {
+		count++
+		for _, c := range []*treeNode[T]{n.left, n.right} {
+			if c == nil {
+				continue
+			}
+			if c.parent != n {
+				return fmt.Errorf("child %v has the wrong parent", c.value)
+			}
+			if h.less(c.value, n.value) {
+				return fmt.Errorf("child %v is less than its parent %v", c.value, n.value)
+			}
+			if err := check(c); err != nil {
+				return err
+			}
+		}
+		if !h.skew {
+			if rank(n.left) < rank(n.right) || n.rank != rank(n.right)+1 {
+				return fmt.Errorf("node %v breaks the leftist property", n.value)
+			}
+		}
+		return nil
+	}
+	if h.root != nil {
+		if h.root.parent != nil {
+			return fmt.Errorf("root has a parent")
+		}
+		if err := check(h.root); err != nil {
+			return err
+		}
+	}
+	if count != h.size {
+		return fmt.Errorf("size is %d but found %d nodes", h.size, count)
+	}
+	return nil
+}
@@ -0,0 +1,84 @@
+package heap
+
+// Node is a handle to an element of a MergeableHeap, returned by Push and
+// accepted by DecreaseKey
+type Node[T any] interface {
+	Value() T
+}
+
+// MergeableHeap is a priority queue that also supports melding two heaps into
+// one and lowering the value of an element in place. H is the implementing type
+// itself, so that Meld only accepts a heap of the same kind:
+// *PairingHeap[T] is a MergeableHeap[T, *PairingHeap[T]].
+//
+// Pop returns the least element under the heap's less function. Meld moves every
+// element of other into the receiver and leaves other empty; handles from other
+// stay valid and now belong to the receiver. DecreaseKey replaces a node's value
+// with one that is not greater and reports false, changing nothing, if the new
+// value is greater, the node has already been popped or it belongs to another
+// heap.
+//
+// Amortized costs (n elements):
+//
+//	          Push      Pop       Meld      DecreaseKey
+//	Pairing   O(1)      O(log n)  O(1)      o(log n)
+//	Binomial  O(log n)  O(log n)  O(log n)  O(log n)
+//	Leftist   O(log n)  O(log n)  O(log n)  O(log n)
+//	Skew      O(log n)  O(log n)  O(log n)  O(log n)
+//	Fibonacci O(1)      O(log n)  O(1)      O(1)
+//
+// Peek is O(1) for all of them except the binomial heap, where it is O(log n).
+type MergeableHeap[T, H any] interface {
+	Len() int
+	Push(v T) Node[T]
+	Pop() (T, bool)
+	Peek() (T, bool)
+	Meld(other H)
+	DecreaseKey(n Node[T], v T) bool
+}
+
+// heapOwner identifies the heap a node was pushed into. Meld must stay O(1) for
+// the pairing and Fibonacci heaps, so instead of visiting the absorbed nodes it
+// points the other heap's owner at the receiver's, union-find style, and nodes
+// resolve their current heap by following those links.
+type heapOwner struct {
+	parent *heapOwner
+}
+
+// find follows the links to the owner of the heap the node is in now, halving
+// the path as it goes so repeated lookups stay cheap
+func (o *heapOwner) find() *heapOwner {
+	for o.parent != nil {
+		if o.parent.parent != nil {
+			o.parent = o.parent.parent
+		}
+		o = o.parent
+	}
+	return o
+}
+
+// ownership is the owner token a heap stamps on the nodes it creates
+type ownership struct {
+	owner *heapOwner
+}
+
+func (s *ownership) token() *heapOwner {
+	if s.owner == nil {
+		s.owner = &heapOwner{}
+	}
+	return s.owner
+}
+
+// owns reports whether a node stamped with o is now in this heap
+func (s *ownership) owns(o *heapOwner) bool {
+	return o != nil && s.owner != nil && o.find() == s.owner
+}
+
+// absorb hands every node of other to this heap in O(1); other gets a fresh
+// token the next time it pushes
+func (s *ownership) absorb(other *ownership) {
+	if other.owner != nil {
+		other.owner.parent = s.token()
+		other.owner = nil
+	}
+}
@@ -0,0 +1,375 @@
+package heap
+
+import (
+	"cmp"
+	"maps"
+	"math/rand"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type validator interface {
+	validate() error
+}
+
+// foreignNode is a handle no heap in this package created
+type foreignNode struct{}
+
+func (foreignNode) Value() int { return 0 }
+
+func TestMergeableHeaps(t *testing.T) {
+	t.Run("Pairing", func(t *testing.T) {
+		testMergeableHeap(t, func() *PairingHeap[int] { return NewPairingHeap(cmp.Less[int]) })
+	})
+	t.Run("Binomial", func(t *testing.T) {
+		testMergeableHeap(t, func() *BinomialHeap[int] { return NewBinomialHeap(cmp.Less[int]) })
+	})
+	t.Run("Leftist", func(t *testing.T) {
+		testMergeableHeap(t, func() *LeftistHeap[int] { return NewLeftistHeap(cmp.Less[int]) })
+	})
+	t.Run("Skew", func(t *testing.T) {
+		testMergeableHeap(t, func() *SkewHeap[int] { return NewSkewHeap(cmp.Less[int]) })
+	})
+	t.Run("Fibonacci", func(t *testing.T) {
+		testMergeableHeap(t, func() *FibonacciHeap[int] { return NewFibonacciHeap(cmp.Less[int]) })
+	})
+}
+
+// testMergeableHeap is the conformance suite every MergeableHeap must pass
+func testMergeableHeap[H MergeableHeap[int, H]](t *testing.T, newHeap func() H) {
+	validate := func(t *testing.T, h H) {
+		t.Helper()
+		v, ok := any(h).(validator)
+		if !ok {
+			t.Fatalf("%T has no validate method", h)
+		}
+		if err := v.validate(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	drain := func(h H) []int {
+		var result []int
+		for h.Len() > 0 {
+			v, _ := h.Pop()
+			result = append(result, v)
+		}
+		return result
+	}
+
+	t.Run("Empty", func(t *testing.T) {
+		h := newHeap()
+		assert.Equal(t, 0, h.Len())
+		_, ok := h.Pop()
+		assert.False(t, ok)
+		_, ok = h.Peek()
+		assert.False(t, ok)
+		h.Meld(newHeap())
+		assert.Equal(t, 0, h.Len())
+		validate(t, h)
+	})
+
+	t.Run("PopsInOrder", func(t *testing.T) {
+		r := rand.New(rand.NewSource(1))
+		h := newHeap()
+		var values []int
+		for range 500 {
+			v := r.Intn(100) // plenty of duplicates
+			values = append(values, v)
+			n := h.Push(v)
+			assert.Equal(t, v, n.Value())
+		}
+		validate(t, h)
+		top, ok := h.Peek()
+		assert.True(t, ok)
+		assert.Equal(t, slices.Min(values), top)
+
+		slices.Sort(values)
+		assert.Equal(t, values, drain(h))
+	})
+
+	t.Run("Meld", func(t *testing.T) {
+		a, b := newHeap(), newHeap()
+		for _, v := range []int{5, 1, 9, 3} {
+			a.Push(v)
+		}
+		var fromB []Node[int]
+		for _, v := range []int{4, 8, 2, 7, 6} {
+			fromB = append(fromB, b.Push(v))
+		}
+		a.Meld(b)
+		assert.Equal(t, 9, a.Len())
+		assert.Equal(t, 0, b.Len())
+		_, ok := b.Pop()
+		assert.False(t, ok)
+		validate(t, a)
+		validate(t, b)
+
+		// Handles from b now belong to a
+		assert.True(t, a.DecreaseKey(fromB[1], 0))
+		top, _ := a.Peek()
+		assert.Equal(t, 0, top)
+
+		a.Meld(a) // melding with itself is a no-op
+		assert.Equal(t, []int{0, 1, 2, 3, 4, 5, 6, 7, 9}, drain(a))
+
+		// The emptied heap is still usable
+		b.Push(1)
+		assert.Equal(t, []int{1}, drain(b))
+	})
+
+	t.Run("DecreaseKey", func(t *testing.T) {
+		h := newHeap()
+		nodes := make([]Node[int], 10)
+		for i := range nodes {
+			nodes[i] = h.Push(i * 10)
+		}
+		assert.True(t, h.DecreaseKey(nodes[7], 5))
+		assert.True(t, h.DecreaseKey(nodes[9], -1))
+		assert.True(t, h.DecreaseKey(nodes[3], 30)) // equal is allowed
+		assert.Equal(t, 5, nodes[7].Value())
+		validate(t, h)
+
+		assert.False(t, h.DecreaseKey(nodes[4], 41)) // an increase
+		assert.Equal(t, 40, nodes[4].Value())
+		assert.False(t, h.DecreaseKey(foreignNode{}, -5))
+
+		v, _ := h.Pop()
+		assert.Equal(t, -1, v)
+		assert.False(t, h.DecreaseKey(nodes[9], -2)) // already popped
+		validate(t, h)
+		assert.Equal(t, []int{0, 5, 10, 20, 30, 40, 50, 60, 80}, drain(h))
+	})
+
+	t.Run("HandleFromAnotherHeap", func(t *testing.T) {
+		a, b := newHeap(), newHeap()
+		fromA := a.Push(10)
+		// Rejected while b is empty - this used to dereference b's nil minimum
+		assert.False(t, b.DecreaseKey(fromA, 5))
+		b.Push(20)
+		assert.False(t, b.DecreaseKey(fromA, 5))
+		assert.Equal(t, 10, fromA.Value())
+		validate(t, a)
+		validate(t, b)
+
+		// After a meld the handle belongs to the receiver and no longer to a
+		c := newHeap()
+		c.Meld(a)
+		assert.False(t, a.DecreaseKey(fromA, 5))
+		assert.True(t, c.DecreaseKey(fromA, 5))
+
+		// Ownership follows a chain of melds, including back into a heap that
+		// has since pushed again
+		fromA2 := a.Push(30)
+		b.Meld(c)
+		a.Meld(b)
+		assert.True(t, a.DecreaseKey(fromA, 4))
+		assert.True(t, a.DecreaseKey(fromA2, 3))
+		assert.False(t, b.DecreaseKey(fromA, 2))
+		assert.False(t, c.DecreaseKey(fromA2, 2))
+		validate(t, a)
+		validate(t, b)
+		validate(t, c)
+		assert.Equal(t, []int{3, 4, 20}, drain(a))
+	})
+
+	t.Run("AgainstModel", func(t *testing.T) {
+		r := rand.New(rand.NewSource(42))
+		h := newHeap()
+		// Values are kept distinct so a popped value identifies its handle
+		handles := map[int]Node[int]{}
+		used := map[int]bool{}
+		fresh := func(lo, hi int) int {
+			for {
+				if v := lo + r.Intn(hi-lo); !used[v] {
+					used[v] = true
+					return v
+				}
+			}
+		}
+		anyHandle := func() Node[int] {
+			for _, n := range handles {
+				return n
+			}
+			return nil
+		}
+		var popped []Node[int]
+
+		for step := range 4000 {
+			switch op := r.Intn(10); {
+			case op < 4 || len(handles) == 0:
+				v := fresh(0, 1_000_000)
+				handles[v] = h.Push(v)
+			case op < 6:
+				want := slices.Min(slices.Collect(maps.Keys(handles)))
+				v, ok := h.Pop()
+				assert.True(t, ok)
+				assert.Equal(t, want, v)
+				popped = append(popped, handles[v])
+				delete(handles, v)
+			case op < 9:
+				n := anyHandle()
+				old := n.Value()
+				v := fresh(old-1000, old)
+				assert.True(t, h.DecreaseKey(n, v))
+				delete(handles, old)
+				handles[v] = n
+			default:
+				other := newHeap()
+				for range r.Intn(20) {
+					v := fresh(0, 1_000_000)
+					handles[v] = other.Push(v)
+				}
+				h.Meld(other)
+			}
+			assert.Equal(t, len(handles), h.Len())
+			if len(popped) > 0 && step%50 == 0 {
+				assert.False(t, h.DecreaseKey(popped[r.Intn(len(popped))], -10_000_000))
+			}
+			if step%100 == 0 {
+				validate(t, h)
+			}
+		}
+		validate(t, h)
+
+		assert.Equal(t, slices.Sorted(maps.Keys(handles)), drain(h))
+	})
+}
+
+const benchmarkHeapSize = 10_000
+
+func benchmarkValues() []int {
+	r := rand.New(rand.NewSource(1))
+	values := make([]int, benchmarkHeapSize)
+	for i := range values {
+		values[i] = r.Intn(1 << 30)
+	}
+	return values
+}
+
+func BenchmarkMergeableHeaps(b *testing.B) {
+	b.Run("Pairing", func(b *testing.B) {
+		benchmarkMergeableHeap(b, func() *PairingHeap[int] { return NewPairingHeap(cmp.Less[int]) })
+	})
+	b.Run("Binomial", func(b *testing.B) {
+		benchmarkMergeableHeap(b, func() *BinomialHeap[int] { return NewBinomialHeap(cmp.Less[int]) })
+	})
+	b.Run("Leftist", func(b *testing.B) {
+		benchmarkMergeableHeap(b, func() *LeftistHeap[int] { return NewLeftistHeap(cmp.Less[int]) })
+	})
+	b.Run("Skew", func(b *testing.B) {
+		benchmarkMergeableHeap(b, func() *SkewHeap[int] { return NewSkewHeap(cmp.Less[int]) })
+	})
+	b.Run("Fibonacci", func(b *testing.B) {
+		benchmarkMergeableHeap(b, func() *FibonacciHeap[int] { return NewFibonacciHeap(cmp.Less[int]) })
+	})
+	b.Run("ContainerHeap", benchmarkContainerHeap)
+}
+
+func benchmarkMergeableHeap[H MergeableHeap[int, H]](b *testing.B, newHeap func() H) {
+	values := benchmarkValues()
+
+	b.Run("PushPop", func(b *testing.B) {
+		for b.Loop() {
+			h := newHeap()
+			for _, v := range values {
+				h.Push(v)
+			}
+			for h.Len() > 0 {
+				h.Pop()
+			}
+		}
+	})
+
+	// Dijkstra-like: every element has its key lowered once before the heap drains
+	b.Run("DecreaseKey", func(b *testing.B) {
+		nodes := make([]Node[int], len(values))
+		for b.Loop() {
+			h := newHeap()
+			for i, v := range values {
+				nodes[i] = h.Push(v)
+			}
+			for i, n := range nodes {
+				h.DecreaseKey(n, n.Value()-values[(i*7)%len(values)]/2)
+			}
+			for h.Len() > 0 {
+				h.Pop()
+			}
+		}
+	})
+
+	// Meld 100 heaps of 100 elements into one, then drain it
+	b.Run("Meld", func(b *testing.B) {
+		for b.Loop() {
+			heaps := make([]H, 100)
+			for i := range heaps {
+				heaps[i] = newHeap()
+				for _, v := range values[i*100 : (i+1)*100] {
+					heaps[i].Push(v)
+				}
+			}
+			for _, h := range heaps[1:] {
+				heaps[0].Meld(h)
+			}
+			for heaps[0].Len() > 0 {
+				heaps[0].Pop()
+			}
+		}
+	})
+}
+
+// benchmarkContainerHeap runs the same workloads on the container/heap-backed
+// Heap and IndexedPriorityQueue, which have no meld, so melding pushes every
+// element of one heap into the other
+func benchmarkContainerHeap(b *testing.B) {
+	values := benchmarkValues()
+
+	b.Run("PushPop", func(b *testing.B) {
+		for b.Loop() {
+			h := NewMin[int]()
+			for _, v := range values {
+				h.Push(v)
+			}
+			for h.Len() > 0 {
+				h.Pop()
+			}
+		}
+	})
+
+	b.Run("DecreaseKey", func(b *testing.B) {
+		handles := make([]*Handle[int, int], len(values))
+		for b.Loop() {
+			q := NewIndexedMinPriorityQueue[int, int]()
+			for i, v := range values {
+				handles[i] = q.Push(i, v)
+			}
+			for i, h := range handles {
+				q.Update(h, h.Priority()-values[(i*7)%len(values)]/2)
+			}
+			for q.Len() > 0 {
+				q.Pop()
+			}
+		}
+	})
+
+	b.Run("Meld", func(b *testing.B) {
+		for b.Loop() {
+			heaps := make([]*Heap[int], 100)
+			for i := range heaps {
+				heaps[i] = NewMin[int]()
+				for _, v := range values[i*100 : (i+1)*100] {
+					heaps[i].Push(v)
+				}
+			}
+			for _, h := range heaps[1:] {
+				for v := range h.Drain() {
+					heaps[0].Push(v)
+				}
+			}
+			for heaps[0].Len() > 0 {
+				heaps[0].Pop()
+			}
+		}
+	})
+}
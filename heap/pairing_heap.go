@@ -0,0 +1,184 @@
+package heap
+
+import "fmt"
+
+type pairingNode[T any] struct {
+	value   T
+	child   *pairingNode[T] // leftmost child
+	sibling *pairingNode[T] // next sibling to the right
+	// prev is the parent for a leftmost child and the left sibling otherwise,
+	// which is all that is needed to cut a node out in O(1)
+	prev    *pairingNode[T]
+	owner   *heapOwner
+	removed bool
+}
+
+func (n *pairingNode[T]) Value() T { return n.value }
+
+// PairingHeap is a heap-ordered multiway tree. Push, Meld and DecreaseKey only
+// ever link two trees, making the one with the larger root the leftmost child
+// of the other; all the restructuring is deferred to Pop, which melds the root's
+// children in pairs left to right and then folds the pairs together right to
+// left. Simple and usually the fastest mergeable heap in practice.
+type PairingHeap[T any] struct {
+	root  *pairingNode[T]
+	size  int
+	less  func(a, b T) bool
+	owner ownership
+}
+
+var _ MergeableHeap[int, *PairingHeap[int]] = (*PairingHeap[int])(nil)
+
+func NewPairingHeap[T any](less func(a, b T) bool) *PairingHeap[T] {
+	return &PairingHeap[T]{less: less}
+}
+
+func (h *PairingHeap[T]) Len() int { return h.size }
+
+// link makes the root with the larger value the leftmost child of the other and
+// returns the winner. Both must be roots; the winner's sibling is left alone.
+func (h *PairingHeap[T]) link(a, b *pairingNode[T]) *pairingNode[T] {
+	if a == nil {
+		return b
+	}
+	if b == nil {
+		return a
+	}
+	if h.less(b.value, a.value) {
+		a, b = b, a
+	}
+	b.prev = a
+	b.sibling = a.child
+	if a.child != nil {
+		a.child.prev = b
+	}
+	a.child = b
+	return a
+}
+
+func (h *PairingHeap[T]) Push(v T) Node[T] {
+	n := &pairingNode[T]{value: v, owner: h.owner.token()}
+	h.root = h.link(h.root, n)
+	h.size++
+	return n
+}
+
+func (h *PairingHeap[T]) Peek() (v T, ok bool) {
+	if h.root == nil {
+		return v, false
+	}
+	return h.root.value, true
+}
+
+func (h *PairingHeap[T]) Pop() (v T, ok bool) {
+	top := h.root
+	if top == nil {
+		return v, false
+	}
+	h.root = h.mergePairs(top.child)
+	h.size--
+	top.child = nil
+	top.removed = true
+	return top.value, true
+}
+
+// mergePairs is the two-pass pairing: link siblings in pairs from the left, then
+// link the results from the right. The first pass stacks the pairs through their
+// sibling pointers, so the second pass pops them off in right to left order.
+func (h *PairingHeap[T]) mergePairs(first *pairingNode[T]) *pairingNode[T] {
+	var pairs *pairingNode[T]
+	for a := first; a != nil; {
+		b := a.sibling
+		var next *pairingNode[T]
+		if b != nil {
+			next = b.sibling
+			b.sibling, b.prev = nil, nil
+		}
+		a.sibling, a.prev = nil, nil
+
+		merged := h.link(a, b)
+		merged.sibling = pairs
+		pairs = merged
+		a = next
+	}
+
+	var result *pairingNode[T]
+	for pairs != nil {
+		next := pairs.sibling
+		pairs.sibling = nil
+		result = h.link(result, pairs)
+		pairs = next
+	}
+	return result
+}
+
+// Meld links the two roots in O(1)
+func (h *PairingHeap[T]) Meld(other *PairingHeap[T]) {
+	if other == h {
+		return
+	}
+	h.root = h.link(h.root, other.root)
+	h.size += other.size
+	other.root, other.size = nil, 0
+	h.owner.absorb(&other.owner)
+}
+
+// DecreaseKey cuts the node's subtree out - still heap ordered, since only its
+// root got smaller - and links it back in at the root
+func (h *PairingHeap[T]) DecreaseKey(n Node[T], v T) bool {
+	node, ok := n.(*pairingNode[T])
+	if !ok || node.removed || !h.owner.owns(node.owner) || h.less(node.value, v) {
+		return false
+	}
+	node.value = v
+	if node.prev == nil {
+		return true // already the root
+	}
+
+	if node.prev.child == node {
+		node.prev.child = node.sibling
+	} else {
+		node.prev.sibling = node.sibling
+	}
+	if node.sibling != nil {
+		node.sibling.prev = node.prev
+	}
+	node.sibling, node.prev = nil, nil
+	h.root = h.link(h.root, node)
+	return true
+}
+
+// validate checks heap order, the prev pointers and the size
+func (h *PairingHeap[T]) validate() error {
+	if h.root != nil && (h.root.prev != nil || h.root.sibling != nil) {
+		return fmt.Errorf("root has a parent or sibling")
+	}
+	count := 0
+	var walk func(parent, n *pairingNode[T]) error
+	walk = func(parent, first *pairingNode[T]) error {
+		prev := parent
+		for n := first; n != nil; prev, n = n, n.sibling {
+			count++
+			if n.prev != prev {
+				return fmt.Errorf("node %v has the wrong prev", n.value)
+			}
+			if h.less(n.value, parent.value) {
+				return fmt.Errorf("child %v is less than its parent %v", n.value, parent.value)
+			}
+			if err := walk(n, n.child); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if h.root != nil {
+		count++
+		if err := walk(h.root, h.root.child); err != nil {
+			return err
+		}
+	}
+	if count != h.size {
+		return fmt.Errorf("size is %d but found %d nodes", h.size, count)
+	}
+	return nil
+}
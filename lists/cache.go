@@ -0,0 +1,120 @@
+package lists
+
+import (
+	"sync"
+	"time"
+)
+
+// EvictionReason says why a cache dropped an entry on its own
+type EvictionReason int
+
+const (
+	// EvictedCapacity means the entry made room for a new one
+	EvictedCapacity EvictionReason = iota
+	// EvictedExpired means the entry's TTL had passed when it was next looked at
+	EvictedExpired
+)
+
+// CacheOptions configures LRUCache and LFUCache. The zero value means no
+// callback, no expiry and the wall clock.
+type CacheOptions[K comparable, V any] struct {
+	// OnEvict is called for every entry the cache drops by itself, but not for
+	// Delete or for values replaced by Put. It runs synchronously, inside any lock
+	// held by SyncCache, so it must not call back into the cache.
+	OnEvict func(key K, value V, reason EvictionReason)
+	// DefaultTTL applies to entries stored with Put; zero means they never expire
+	DefaultTTL time.Duration
+	// Clock returns the current time; inject a fake to test expiry
+	Clock func() time.Time
+}
+
+func (o *CacheOptions[K, V]) now() time.Time {
+	if o.Clock == nil {
+		return time.Now()
+	}
+	return o.Clock()
+}
+
+// expiry returns the deadline for an entry stored now with ttl, or the zero
+// time if it never expires
+func (o *CacheOptions[K, V]) expiry(ttl time.Duration) time.Time {
+	if ttl <= 0 {
+		return time.Time{}
+	}
+	return o.now().Add(ttl)
+}
+
+func (o *CacheOptions[K, V]) expired(deadline time.Time) bool {
+	return !deadline.IsZero() && !o.now().Before(deadline)
+}
+
+func (o *CacheOptions[K, V]) evicted(key K, value V, reason EvictionReason) {
+	if o.OnEvict != nil {
+		o.OnEvict(key, value, reason)
+	}
+}
+
+type CacheStats struct {
+	Hits      uint64
+	Misses    uint64
+	Evictions uint64 // capacity evictions plus expirations
+}
+
+// Cache is the interface shared by the LRU and LFU caches. Expired entries are
+// removed lazily, when a Get or Put finds them, so Len may count entries whose
+// TTL has passed but have not been looked at since.
+type Cache[K comparable, V any] interface {
+	Get(key K) (V, bool)
+	Put(key K, value V)
+	PutWithTTL(key K, value V, ttl time.Duration)
+	Delete(key K) bool
+	Len() int
+	Stats() CacheStats
+}
+
+// SyncCache makes any Cache safe for concurrent use. A plain mutex is used rather
+// than a read/write lock because every Get updates recency or frequency.
+type SyncCache[K comparable, V any] struct {
+	mu    sync.Mutex
+	cache Cache[K, V]
+}
+
+func NewSyncCache[K comparable, V any](cache Cache[K, V]) *SyncCache[K, V] {
+	return &SyncCache[K, V]{cache: cache}
+}
+
+func (c *SyncCache[K, V]) Get(key K) (V, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.cache.Get(key)
+}
+
+func (c *SyncCache[K, V]) Put(key K, value V) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.cache.Put(key, value)
+}
+
+func (c *SyncCache[K, V]) PutWithTTL(key K, value V, ttl time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.cache.PutWithTTL(key, value, ttl)
+}
+
+func (c *SyncCache[K, V]) Delete(key K) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.cache.Delete(key)
+}
+
+func (c *SyncCache[K, V]) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.cache.Len()
+}
+
+func (c *SyncCache[K, V]) Stats() CacheStats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.cache.Stats()
+}
@@ -0,0 +1,107 @@
+package lists
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeClock is advanced by hand so TTL tests do not sleep
+type fakeClock struct {
+	now time.Time
+}
+
+func (f *fakeClock) Now() time.Time { return f.now }
+
+func (f *fakeClock) Advance(d time.Duration) { f.now = f.now.Add(d) }
+
+func caches[V any](capacity int, opts CacheOptions[string, V]) map[string]Cache[string, V] {
+	return map[string]Cache[string, V]{
+		"LRU": NewLRUCache(capacity, opts),
+		"LFU": NewLFUCache(capacity, opts),
+	}
+}
+
+func TestCacheTTL(t *testing.T) {
+	clock := &fakeClock{now: time.Unix(0, 0)}
+	var reasons []EvictionReason
+	opts := CacheOptions[string, int]{
+		Clock:      clock.Now,
+		DefaultTTL: time.Minute,
+		OnEvict:    func(key string, value int, reason EvictionReason) { reasons = append(reasons, reason) },
+	}
+
+	for name, c := range caches(10, opts) {
+		reasons = nil
+		c.Put("default", 1)
+		c.PutWithTTL("short", 2, time.Second)
+		c.PutWithTTL("forever", 3, 0)
+
+		clock.Advance(time.Second)
+		_, ok := c.Get("short")
+		assert.False(t, ok, name)
+		_, ok = c.Get("default")
+		assert.True(t, ok, name)
+
+		clock.Advance(time.Minute)
+		_, ok = c.Get("default")
+		assert.False(t, ok, name)
+		v, ok := c.Get("forever")
+		assert.True(t, ok, name)
+		assert.Equal(t, 3, v, name)
+
+		assert.Equal(t, []EvictionReason{EvictedExpired, EvictedExpired}, reasons, name)
+		assert.Equal(t, CacheStats{Hits: 2, Misses: 2, Evictions: 2}, c.Stats(), name)
+		assert.Equal(t, 1, c.Len(), name)
+	}
+}
+
+func TestCacheEvictionReportsExpiredVictim(t *testing.T) {
+	clock := &fakeClock{now: time.Unix(0, 0)}
+	var reasons []EvictionReason
+	opts := CacheOptions[string, int]{
+		Clock:   clock.Now,
+		OnEvict: func(key string, value int, reason EvictionReason) { reasons = append(reasons, reason) },
+	}
+	for name, c := range caches(1, opts) {
+		reasons = nil
+		c.PutWithTTL("a", 1, time.Second)
+		clock.Advance(time.Hour)
+		c.Put("b", 2)
+		assert.Equal(t, []EvictionReason{EvictedExpired}, reasons, name)
+	}
+}
+
+// Run with -race
+func TestSyncCacheConcurrentUse(t *testing.T) {
+	for name, inner := range caches(64, CacheOptions[string, int]{}) {
+		c := NewSyncCache(inner)
+		var wg sync.WaitGroup
+		for w := 0; w < 8; w++ {
+			wg.Go(func() {
+				for i := 0; i < 1000; i++ {
+					key := fmt.Sprintf("k%d", (w*31+i)%100)
+					switch i % 4 {
+					case 0:
+						c.Put(key, i)
+					case 1:
+						c.PutWithTTL(key, i, time.Hour)
+					case 2:
+						c.Get(key)
+					case 3:
+						if i%20 == 3 {
+							c.Delete(key)
+						}
+					}
+				}
+			})
+		}
+		wg.Wait()
+		assert.LessOrEqual(t, c.Len(), 64, name)
+		stats := c.Stats()
+		assert.Equal(t, uint64(8*250), stats.Hits+stats.Misses, name)
+	}
+}
@@ -0,0 +1,127 @@
+package lists
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+// queueNode is shared by both concurrent queues. next is atomic even in the
+// two-lock queue because an enqueuer and a dequeuer holding different locks both
+// touch the dummy node's next when the queue is empty.
+type queueNode[T any] struct {
+	value T
+	next  atomic.Pointer[queueNode[T]]
+}
+
+// LockFreeQueue is the Michael-Scott non-blocking multi-producer multi-consumer
+// FIFO queue. head always points at a dummy node whose successor holds the front
+// value and tail points at the last node or, briefly, the one before it; any
+// goroutine that sees tail lagging swings it forward before retrying, so no
+// operation ever waits for another to finish. Go's garbage collector keeps a
+// node alive while any goroutine still holds it, which rules out the ABA problem
+// the original paper needs counted pointers for.
+// Use NewLockFreeQueue to create one.
+type LockFreeQueue[T any] struct {
+	head atomic.Pointer[queueNode[T]]
+	tail atomic.Pointer[queueNode[T]]
+}
+
+func NewLockFreeQueue[T any]() *LockFreeQueue[T] {
+	q := &LockFreeQueue[T]{}
+	dummy := &queueNode[T]{}
+	q.head.Store(dummy)
+	q.tail.Store(dummy)
+	return q
+}
+
+// Enqueue appends v at the back of the queue
+func (q *LockFreeQueue[T]) Enqueue(v T) {
+	node := &queueNode[T]{value: v}
+	for {
+		tail := q.tail.Load()
+		next := tail.next.Load()
+		if tail != q.tail.Load() {
+			continue // tail moved while we read next
+		}
+		if next != nil {
+			// Another enqueue linked its node but has not swung tail yet
+			q.tail.CompareAndSwap(tail, next)
+			continue
+		}
+		if tail.next.CompareAndSwap(nil, node) {
+			// Failing here is fine: someone else already advanced tail
+			q.tail.CompareAndSwap(tail, node)
+			return
+		}
+	}
+}
+
+// Dequeue removes and returns the front value; ok is false if the queue was
+// empty. The dequeued node becomes the new dummy, so its value stays reachable
+// until the next Dequeue.
+func (q *LockFreeQueue[T]) Dequeue() (v T, ok bool) {
+	for {
+		head := q.head.Load()
+		tail := q.tail.Load()
+		next := head.next.Load()
+		if head != q.head.Load() {
+			continue
+		}
+		if next == nil {
+			return v, false
+		}
+		if head == tail {
+			// Tail is lagging behind a node that is already linked
+			q.tail.CompareAndSwap(tail, next)
+			continue
+		}
+		// Read the value before the swap: once head moves another dequeue may
+		// take next as its dummy
+		value := next.value
+		if q.head.CompareAndSwap(head, next) {
+			return value, true
+		}
+	}
+}
+
+// TwoLockQueue is the blocking queue from the same Michael-Scott paper: one lock
+// for the head and one for the tail, so a producer and a consumer never contend
+// with each other, only with their own kind. The dummy node keeps them apart even
+// when the queue is empty. Use NewTwoLockQueue to create one.
+type TwoLockQueue[T any] struct {
+	headMu sync.Mutex
+	head   *queueNode[T]
+	tailMu sync.Mutex
+	tail   *queueNode[T]
+}
+
+func NewTwoLockQueue[T any]() *TwoLockQueue[T] {
+	dummy := &queueNode[T]{}
+	return &TwoLockQueue[T]{head: dummy, tail: dummy}
+}
+
+// Enqueue appends v at the back of the queue
+func (q *TwoLockQueue[T]) Enqueue(v T) {
+	node := &queueNode[T]{value: v}
+	q.tailMu.Lock()
+	q.tail.next.Store(node)
+	q.tail = node
+	q.tailMu.Unlock()
+}
+
+// Dequeue removes and returns the front value; ok is false if the queue was empty
+func (q *TwoLockQueue[T]) Dequeue() (v T, ok bool) {
+	q.headMu.Lock()
+	defer q.headMu.Unlock()
+	next := q.head.next.Load()
+	if next == nil {
+		return v, false
+	}
+	v = next.value
+	// next becomes the dummy; the enqueuer never reads value, so clearing it is
+	// safe and lets the garbage collector reclaim it
+	var zero T
+	next.value = zero
+	q.head = next
+	return v, true
+}
@@ -0,0 +1,143 @@
+package lists
+
+import (
+	"runtime"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type concurrentQueue interface {
+	Enqueue(v int)
+	Dequeue() (int, bool)
+}
+
+func concurrentQueues() map[string]func() concurrentQueue {
+	return map[string]func() concurrentQueue{
+		"LockFree": func() concurrentQueue { return NewLockFreeQueue[int]() },
+		"TwoLock":  func() concurrentQueue { return NewTwoLockQueue[int]() },
+	}
+}
+
+func TestConcurrentQueueFIFO(t *testing.T) {
+	for name, newQueue := range concurrentQueues() {
+		t.Run(name, func(t *testing.T) {
+			q := newQueue()
+			_, ok := q.Dequeue()
+			assert.False(t, ok)
+
+			for i := 1; i <= 3; i++ {
+				q.Enqueue(i)
+			}
+			v, ok := q.Dequeue()
+			assert.True(t, ok)
+			assert.Equal(t, 1, v)
+
+			q.Enqueue(4)
+			for _, want := range []int{2, 3, 4} {
+				v, ok = q.Dequeue()
+				assert.True(t, ok)
+				assert.Equal(t, want, v)
+			}
+			_, ok = q.Dequeue()
+			assert.False(t, ok)
+		})
+	}
+}
+
+// Every value must come out exactly once, and values from one producer must come
+// out in the order that producer enqueued them
+func TestConcurrentQueueStress(t *testing.T) {
+	const producers, consumers = 4, 4
+	perProducer := 20000
+	if testing.Short() {
+		perProducer = 2000
+	}
+
+	for name, newQueue := range concurrentQueues() {
+		t.Run(name, func(t *testing.T) {
+			q := newQueue()
+			total := producers * perProducer
+
+			var wg sync.WaitGroup
+			for p := range producers {
+				wg.Go(func() {
+					for i := range perProducer {
+						q.Enqueue(p*perProducer + i)
+					}
+				})
+			}
+
+			received := make([][]int, consumers)
+			var remaining sync.WaitGroup
+			remaining.Add(total)
+			done := make(chan struct{})
+			go func() {
+				remaining.Wait()
+				close(done)
+			}()
+			for c := range consumers {
+				wg.Go(func() {
+					for {
+						if v, ok := q.Dequeue(); ok {
+							received[c] = append(received[c], v)
+							remaining.Done()
+							continue
+						}
+						select {
+						case <-done:
+							return
+						default:
+							runtime.Gosched()
+						}
+					}
+				})
+			}
+			wg.Wait()
+
+			seen := make([]bool, total)
+			for _, values := range received {
+				last := make([]int, producers)
+				for p := range last {
+					last[p] = -1
+				}
+				for _, v := range values {
+					assert.False(t, seen[v], "value %d dequeued twice", v)
+					seen[v] = true
+					p := v / perProducer
+					assert.Greater(t, v, last[p], "producer %d out of order", p)
+					last[p] = v
+				}
+			}
+			for v, ok := range seen {
+				if !ok {
+					t.Fatalf("value %d was never dequeued", v)
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkConcurrentQueue(b *testing.B) {
+	for name, newQueue := range concurrentQueues() {
+		b.Run(name, func(b *testing.B) {
+			q := newQueue()
+			b.RunParallel(func(pb *testing.PB) {
+				for pb.Next() {
+					q.Enqueue(1)
+					q.Dequeue()
+				}
+			})
+		})
+	}
+	b.Run("BufferedChannel", func(b *testing.B) {
+		ch := make(chan int, 1024)
+		b.RunParallel(func(pb *testing.PB) {
+			for pb.Next() {
+				ch <- 1
+				<-ch
+			}
+		})
+	})
+}
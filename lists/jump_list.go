@@ -0,0 +1,200 @@
+package lists
+
+// JumpListElement is a list node with two extra pointers: Jump may point at any
+// node in the same structure (or be nil) and Child may start a sub-list one level
+// down, which can have children of its own. Child lists are disjoint trees; Jump
+// pointers may form any shape, including cycles.
+type JumpListElement struct {
+	Value int
+	Next  *JumpListElement
+	Jump  *JumpListElement
+	Child *JumpListElement
+}
+
+/**
+ * Flatten a multilevel list into a single level, in place, level by level: every
+ * child list is appended to the end of the list when the walk reaches its parent,
+ * so the walk goes on to flatten the child's own children later. Child pointers
+ * are left as they are, which is what lets unflattenJumpList undo this.
+ *
+ * 1 - 2 - 3            flattens to  1 - 2 - 3 - 4 - 5 - 6
+ *     |
+ *     4 - 5
+ *         |
+ *         6
+ *
+ * Time Complexity: O(n) - the tail pointer passes each node once
+ * Space Complexity: O(1)
+ */
+func flattenJumpList(head *JumpListElement) *JumpListElement {
+	if head == nil {
+		return nil
+	}
+	tail := head
+	for tail.Next != nil {
+		tail = tail.Next
+	}
+
+	for current := head; current != nil; current = current.Next {
+		if current.Child != nil {
+			tail.Next = current.Child
+			for tail.Next != nil {
+				tail = tail.Next
+			}
+		}
+	}
+	return head
+}
+
+/**
+ * Undo flattenJumpList. The flattened list is the top level followed by one
+ * segment per child list, in the order their parents appear, and a segment ends
+ * just before the next segment's first node - the child of the next parent.
+ * Cutting the segments off in reverse order keeps everything still to be cut
+ * a contiguous prefix starting at head, which contains all the parents of
+ * the remaining segments, so no extra storage is needed to find them.
+ *
+ * Time Complexity: O(n*c) for c child lists
+ * Space Complexity: O(1)
+ */
+func unflattenJumpList(head *JumpListElement) *JumpListElement {
+	parents := 0
+	for n := head; n != nil; n = n.Next {
+		if n.Child != nil {
+			parents++
+		}
+	}
+
+	for k := parents; k > 0; k-- {
+		// Find the k'th parent, whose child starts the last segment still attached
+		parent, seen := head, 0
+		for ; ; parent = parent.Next {
+			if parent.Child != nil {
+				seen++
+				if seen == k {
+					break
+				}
+			}
+		}
+		// A child segment always follows its parent
+		end := parent
+		for end.Next != parent.Child {
+			end = end.Next
+		}
+		end.Next = nil
+	}
+	return head
+}
+
+/**
+ * Deep copy of a multilevel list with jump pointers
+ *
+ * Brute force keeps a map from each node to its copy: O(n) extra space.
+ * The interleaving technique stores that map in the list itself: insert each
+ * node's copy directly after it, so the copy of any node x is x.Next and the
+ * copy's Jump and Child are just x.Jump.Next and x.Child.Next. Then split the
+ * interleaved list back into the original and the copy. The child levels are
+ * handled by flattening first so a single linear walk reaches every node, and
+ * both lists are unflattened at the end. The original is left unchanged.
+ *
+ * Time Complexity: O(n*c) for c child lists, O(n) for a single-level list
+ * Space Complexity: O(1) besides the copy
+ */
+func copyJumpList(head *JumpListElement) *JumpListElement {
+	if head == nil {
+		return nil
+	}
+	flattenJumpList(head)
+
+	// A - B - C  becomes  A - A' - B - B' - C - C'
+	for n := head; n != nil; n = n.Next.Next {
+		n.Next = &JumpListElement{Value: n.Value, Next: n.Next}
+	}
+
+	for n := head; n != nil; n = n.Next.Next {
+		copied := n.Next
+		if n.Jump != nil {
+			copied.Jump = n.Jump.Next
+		}
+		if n.Child != nil {
+			copied.Child = n.Child.Next
+		}
+	}
+
+	copyHead := head.Next
+	for n := head; n != nil; n = n.Next {
+		copied := n.Next
+		n.Next = copied.Next
+		if copied.Next != nil {
+			copied.Next = copied.Next.Next
+		}
+	}
+
+	unflattenJumpList(head)
+	return unflattenJumpList(copyHead)
+}
+
+// indexJumpList numbers every node reachable through Next and Child, visiting
+// each level before the child lists hanging off it in depth-first order
+func indexJumpList(head *JumpListElement) ([]*JumpListElement, map[*JumpListElement]int) {
+	var nodes []*JumpListElement
+	index := make(map[*JumpListElement]int)
+	stack := []*JumpListElement{head}
+
+	for len(stack) > 0 {
+		n := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		for ; n != nil; n = n.Next {
+			if _, seen := index[n]; seen {
+				break
+			}
+			index[n] = len(nodes)
+			nodes = append(nodes, n)
+			if n.Child != nil {
+				stack = append(stack, n.Child)
+			}
+		}
+	}
+	return nodes, index
+}
+
+/**
+ * Structural equality: the two lists have the same shape, the same values in the
+ * same places, and their Jump pointers point at corresponding nodes - regardless
+ * of the nodes' addresses. Numbering the nodes of both lists in the same traversal
+ * order reduces this to comparing, node by node, the value and the numbers of the
+ * Next, Child and Jump targets. Jump pointers to nodes outside a list never
+ * compare equal.
+ *
+ * Time Complexity: O(n)
+ * Space Complexity: O(n)
+ */
+func equalJumpLists(a, b *JumpListElement) bool {
+	nodesA, indexA := indexJumpList(a)
+	nodesB, indexB := indexJumpList(b)
+	if len(nodesA) != len(nodesB) {
+		return false
+	}
+
+	position := func(index map[*JumpListElement]int, n *JumpListElement) (int, bool) {
+		if n == nil {
+			return -1, true
+		}
+		i, ok := index[n]
+		return i, ok
+	}
+	samePosition := func(x, y *JumpListElement) bool {
+		i, okA := position(indexA, x)
+		j, okB := position(indexB, y)
+		return okA && okB && i == j
+	}
+
+	for i, x := range nodesA {
+		y := nodesB[i]
+		if x.Value != y.Value || !samePosition(x.Next, y.Next) ||
+			!samePosition(x.Child, y.Child) || !samePosition(x.Jump, y.Jump) {
+			return false
+		}
+	}
+	return true
+}
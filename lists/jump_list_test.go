@@ -0,0 +1,155 @@
+package lists
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// buildDocument returns the nodes of
+//
+//	1 - 2 - 3 - 4
+//	    |       |
+//	    5 - 6   7 - 8
+//	        |
+//	        9
+//
+// indexed by value, with jumps 1->8, 3->3, 5->3, 8->1 and 9->2
+func buildDocument() map[int]*JumpListElement {
+	nodes := make(map[int]*JumpListElement)
+	for v := 1; v <= 9; v++ {
+		nodes[v] = &JumpListElement{Value: v}
+	}
+	link := func(values ...int) {
+		for i := 0; i+1 < len(values); i++ {
+			nodes[values[i]].Next = nodes[values[i+1]]
+		}
+	}
+	link(1, 2, 3, 4)
+	link(5, 6)
+	link(7, 8)
+	nodes[2].Child = nodes[5]
+	nodes[6].Child = nodes[9]
+	nodes[4].Child = nodes[7]
+	for from, to := range map[int]int{1: 8, 3: 3, 5: 3, 8: 1, 9: 2} {
+		nodes[from].Jump = nodes[to]
+	}
+	return nodes
+}
+
+func jumpListValues(head *JumpListElement) []int {
+	var values []int
+	for n := head; n != nil; n = n.Next {
+		values = append(values, n.Value)
+	}
+	return values
+}
+
+func TestFlattenAndUnflattenJumpList(t *testing.T) {
+	nodes := buildDocument()
+	head := flattenJumpList(nodes[1])
+	assert.Equal(t, []int{1, 2, 3, 4, 5, 6, 7, 8, 9}, jumpListValues(head))
+	assert.Same(t, nodes[5], nodes[2].Child)
+
+	head = unflattenJumpList(head)
+	assert.Same(t, nodes[1], head)
+	assert.True(t, equalJumpLists(head, buildDocument()[1]))
+	assert.Equal(t, []int{1, 2, 3, 4}, jumpListValues(head))
+	assert.Equal(t, []int{5, 6}, jumpListValues(nodes[2].Child))
+	assert.Equal(t, []int{9}, jumpListValues(nodes[6].Child))
+	assert.Equal(t, []int{7, 8}, jumpListValues(nodes[4].Child))
+}
+
+func TestFlattenChildOfLastNode(t *testing.T) {
+	// The parent is the last node of its level, so its child directly follows it
+	a, b, c := &JumpListElement{Value: 1}, &JumpListElement{Value: 2}, &JumpListElement{Value: 3}
+	a.Child = b
+	b.Child = c
+	assert.Equal(t, []int{1, 2, 3}, jumpListValues(flattenJumpList(a)))
+	unflattenJumpList(a)
+	assert.Nil(t, a.Next)
+	assert.Nil(t, b.Next)
+	assert.Same(t, b, a.Child)
+	assert.Same(t, c, b.Child)
+
+	assert.Nil(t, flattenJumpList(nil))
+	assert.Nil(t, unflattenJumpList(nil))
+}
+
+func TestCopyJumpList(t *testing.T) {
+	nodes := buildDocument()
+	copied := copyJumpList(nodes[1])
+
+	assert.True(t, equalJumpLists(nodes[1], copied))
+	// The original is restored exactly
+	assert.True(t, equalJumpLists(nodes[1], buildDocument()[1]))
+	assert.Same(t, nodes[5], nodes[2].Child)
+	assert.Same(t, nodes[8], nodes[1].Jump)
+
+	// No node is shared, and every copied pointer stays within the copy
+	copiedNodes, copiedIndex := indexJumpList(copied)
+	_, originalIndex := indexJumpList(nodes[1])
+	for _, n := range copiedNodes {
+		_, shared := originalIndex[n]
+		assert.False(t, shared, "node %d is shared", n.Value)
+		if n.Jump != nil {
+			_, inside := copiedIndex[n.Jump]
+			assert.True(t, inside, "jump of %d leaves the copy", n.Value)
+		}
+	}
+
+	// Changing the copy leaves the original alone
+	copied.Next.Child.Value = 50
+	copied.Jump = nil
+	assert.Equal(t, 5, nodes[5].Value)
+	assert.Same(t, nodes[8], nodes[1].Jump)
+	assert.False(t, equalJumpLists(nodes[1], copied))
+}
+
+func TestCopyJumpListSingleLevel(t *testing.T) {
+	assert.Nil(t, copyJumpList(nil))
+
+	single := &JumpListElement{Value: 7}
+	single.Jump = single
+	copied := copyJumpList(single)
+	assert.NotSame(t, single, copied)
+	assert.Same(t, copied, copied.Jump)
+	assert.Nil(t, single.Next)
+	assert.Nil(t, copied.Next)
+
+	a, b, c := &JumpListElement{Value: 1}, &JumpListElement{Value: 2}, &JumpListElement{Value: 3}
+	a.Next, b.Next = b, c
+	a.Jump, b.Jump, c.Jump = c, a, b
+	copied = copyJumpList(a)
+	assert.True(t, equalJumpLists(a, copied))
+	assert.Equal(t, []int{1, 2, 3}, jumpListValues(copied))
+	assert.Equal(t, 3, copied.Jump.Value)
+	assert.Same(t, copied, copied.Next.Jump)
+}
+
+func TestEqualJumpLists(t *testing.T) {
+	assert.True(t, equalJumpLists(nil, nil))
+	assert.False(t, equalJumpLists(nil, &JumpListElement{}))
+	assert.True(t, equalJumpLists(buildDocument()[1], buildDocument()[1]))
+
+	different := buildDocument()
+	different[9].Value = 10
+	assert.False(t, equalJumpLists(buildDocument()[1], different[1]))
+
+	// Same values, but one jump points at a different node
+	different = buildDocument()
+	different[5].Jump = different[4]
+	assert.False(t, equalJumpLists(buildDocument()[1], different[1]))
+
+	// Same values in the same traversal order, but a different shape
+	different = buildDocument()
+	different[6].Child = nil
+	different[6].Next = different[9]
+	assert.False(t, equalJumpLists(buildDocument()[1], different[1]))
+
+	// A jump out of the structure never matches
+	a, b := buildDocument(), buildDocument()
+	outside := &JumpListElement{}
+	a[3].Jump, b[3].Jump = outside, outside
+	assert.False(t, equalJumpLists(a[1], b[1]))
+}
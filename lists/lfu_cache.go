@@ -0,0 +1,141 @@
+package lists
+
+import "time"
+
+// lfuBucket holds every entry used exactly frequency times, most recent first,
+// so the least recently used entry of the bucket is at the back
+type lfuBucket[K comparable, V any] struct {
+	frequency int
+	entries   List[cacheEntry[K, V]]
+}
+
+// lfuItem locates an entry: its element in the bucket's list and the bucket's
+// element in the list of buckets
+type lfuItem[K comparable, V any] struct {
+	bucket *Element[*lfuBucket[K, V]]
+	entry  *Element[cacheEntry[K, V]]
+}
+
+// LFUCache is a bounded cache that evicts the least frequently used entry,
+// breaking ties by evicting the least recently used one.
+//
+// All operations are O(1): buckets of equal frequency are kept in a list in
+// increasing frequency order, with no empty buckets. A use moves an entry from
+// its bucket to the next one, creating it if the next bucket is not exactly one
+// higher, and the entry to evict is always at the back of the first bucket.
+type LFUCache[K comparable, V any] struct {
+	capacity int
+	items    map[K]*lfuItem[K, V]
+	buckets  List[*lfuBucket[K, V]]
+	opts     CacheOptions[K, V]
+	stats    CacheStats
+}
+
+// NewLFUCache returns a cache holding at most capacity entries (at least 1)
+func NewLFUCache[K comparable, V any](capacity int, opts CacheOptions[K, V]) *LFUCache[K, V] {
+	return &LFUCache[K, V]{
+		capacity: max(capacity, 1),
+		items:    make(map[K]*lfuItem[K, V]),
+		opts:     opts,
+	}
+}
+
+func (c *LFUCache[K, V]) Get(key K) (V, bool) {
+	item, ok := c.items[key]
+	if ok && c.opts.expired(item.entry.Value.expires) {
+		c.remove(item, EvictedExpired)
+		ok = false
+	}
+	if !ok {
+		c.stats.Misses++
+		var zero V
+		return zero, false
+	}
+	c.stats.Hits++
+	c.touch(item)
+	return item.entry.Value.value, true
+}
+
+// frequency reports how many times key has been used, 0 if absent
+func (c *LFUCache[K, V]) frequency(key K) int {
+	if item, ok := c.items[key]; ok {
+		return item.bucket.Value.frequency
+	}
+	return 0
+}
+
+// touch moves an entry up to the bucket for one more use
+func (c *LFUCache[K, V]) touch(item *lfuItem[K, V]) {
+	bucket := item.bucket
+	next := bucket.Next()
+	if next == nil || next.Value.frequency != bucket.Value.frequency+1 {
+		next = c.buckets.InsertAfter(&lfuBucket[K, V]{frequency: bucket.Value.frequency + 1}, bucket)
+	}
+	entry := bucket.Value.entries.Remove(item.entry)
+	item.entry = next.Value.entries.PushFront(entry)
+	item.bucket = next
+	if bucket.Value.entries.Len() == 0 {
+		c.buckets.Remove(bucket)
+	}
+}
+
+func (c *LFUCache[K, V]) Put(key K, value V) {
+	c.PutWithTTL(key, value, c.opts.DefaultTTL)
+}
+
+// PutWithTTL stores value for key, expiring after ttl (never if ttl <= 0).
+// Storing an existing key replaces its value and counts as a use.
+func (c *LFUCache[K, V]) PutWithTTL(key K, value V, ttl time.Duration) {
+	entry := cacheEntry[K, V]{key: key, value: value, expires: c.opts.expiry(ttl)}
+	if item, ok := c.items[key]; ok {
+		item.entry.Value = entry
+		c.touch(item)
+		return
+	}
+	if len(c.items) >= c.capacity {
+		c.evict()
+	}
+
+	first := c.buckets.Front()
+	if first == nil || first.Value.frequency != 1 {
+		first = c.buckets.PushFront(&lfuBucket[K, V]{frequency: 1})
+	}
+	c.items[key] = &lfuItem[K, V]{bucket: first, entry: first.Value.entries.PushFront(entry)}
+}
+
+func (c *LFUCache[K, V]) evict() {
+	victim := c.buckets.Front().Value.entries.Back()
+	reason := EvictedCapacity
+	if c.opts.expired(victim.Value.expires) {
+		reason = EvictedExpired
+	}
+	c.remove(c.items[victim.Value.key], reason)
+}
+
+func (c *LFUCache[K, V]) unlink(item *lfuItem[K, V]) cacheEntry[K, V] {
+	entry := item.bucket.Value.entries.Remove(item.entry)
+	if item.bucket.Value.entries.Len() == 0 {
+		c.buckets.Remove(item.bucket)
+	}
+	delete(c.items, entry.key)
+	return entry
+}
+
+func (c *LFUCache[K, V]) remove(item *lfuItem[K, V], reason EvictionReason) {
+	entry := c.unlink(item)
+	c.stats.Evictions++
+	c.opts.evicted(entry.key, entry.value, reason)
+}
+
+func (c *LFUCache[K, V]) Delete(key K) bool {
+	item, ok := c.items[key]
+	if !ok {
+		return false
+	}
+	c.unlink(item)
+	return true
+}
+
+func (c *LFUCache[K, V]) Len() int { return len(c.items) }
+
+func (c *LFUCache[K, V]) Stats() CacheStats { return c.stats }
@@ -0,0 +1,89 @@
+package lists
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLFUCacheEvictsLeastFrequentlyUsed(t *testing.T) {
+	var evicted []string
+	c := NewLFUCache(2, CacheOptions[string, int]{
+		OnEvict: func(key string, value int, reason EvictionReason) { evicted = append(evicted, key) },
+	})
+	c.Put("a", 1)
+	c.Put("b", 2)
+	c.Get("a")
+	c.Get("a")
+	c.Get("b")
+	c.Put("c", 3) // b used twice, a three times
+
+	assert.Equal(t, []string{"b"}, evicted)
+	assert.Equal(t, 3, c.frequency("a"))
+	assert.Equal(t, 1, c.frequency("c"))
+	assert.Equal(t, 0, c.frequency("b"))
+
+	c.Put("d", 4) // c has the lowest frequency
+	assert.Equal(t, []string{"b", "c"}, evicted)
+}
+
+func TestLFUCacheBreaksTiesByRecency(t *testing.T) {
+	c := NewLFUCache(3, CacheOptions[int, int]{})
+	c.Put(1, 1)
+	c.Put(2, 2)
+	c.Put(3, 3)
+	c.Get(3)
+	c.Get(1)
+	c.Get(2) // all used twice, 3 least recently
+	c.Put(4, 4)
+
+	_, ok := c.Get(3)
+	assert.False(t, ok)
+	for _, k := range []int{1, 2, 4} {
+		_, ok := c.Get(k)
+		assert.True(t, ok)
+	}
+}
+
+func TestLFUCachePutCountsAsUse(t *testing.T) {
+	c := NewLFUCache(2, CacheOptions[int, string]{})
+	c.Put(1, "one")
+	c.Put(1, "uno")
+	assert.Equal(t, 2, c.frequency(1))
+	v, _ := c.Get(1)
+	assert.Equal(t, "uno", v)
+	assert.Equal(t, 1, c.Len())
+}
+
+func TestLFUCacheBucketsStayCompact(t *testing.T) {
+	c := NewLFUCache(4, CacheOptions[int, int]{})
+	c.Put(1, 1)
+	c.Put(2, 2)
+	for i := 0; i < 5; i++ {
+		c.Get(1)
+	}
+	// Only frequencies 1 and 6 are in use
+	var frequencies []int
+	for b := range c.buckets.All() {
+		frequencies = append(frequencies, b.frequency)
+	}
+	assert.Equal(t, []int{1, 6}, frequencies)
+
+	c.Delete(2)
+	frequencies = nil
+	for b := range c.buckets.All() {
+		frequencies = append(frequencies, b.frequency)
+	}
+	assert.Equal(t, []int{6}, frequencies)
+	assert.False(t, c.Delete(2))
+}
+
+func TestLFUCacheStats(t *testing.T) {
+	c := NewLFUCache(1, CacheOptions[int, int]{})
+	c.Put(1, 1)
+	c.Get(1)
+	c.Get(1)
+	c.Get(2)
+	c.Put(2, 2)
+	assert.Equal(t, CacheStats{Hits: 2, Misses: 1, Evictions: 1}, c.Stats())
+}
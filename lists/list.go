@@ -0,0 +1,379 @@
+package lists
+
+import "iter"
+
+// Element is a node of a doubly linked List
+type Element[T any] struct {
+	Value      T
+	next, prev *Element[T]
+	list       *List[T]
+}
+
+// Next returns the following element or nil at the back of the list
+func (e *Element[T]) Next() *Element[T] {
+	if n := e.next; e.list != nil && n != &e.list.root {
+		return n
+	}
+	return nil
+}
+
+// Prev returns the preceding element or nil at the front of the list
+func (e *Element[T]) Prev() *Element[T] {
+	if p := e.prev; e.list != nil && p != &e.list.root {
+		return p
+	}
+	return nil
+}
+
+// List is a doubly linked list with O(1) length. It is built around a sentinel
+// root element whose next is the front and whose prev is the back, so the empty
+// list needs no special cases (the same role dummyHead plays in the functions
+// on ListElement). The zero value is an empty list ready to use.
+type List[T any] struct {
+	root   Element[T]
+	length int
+}
+
+// NewList returns a list holding values in order
+func NewList[T any](values ...T) *List[T] {
+	l := &List[T]{}
+	for _, v := range values {
+		l.PushBack(v)
+	}
+	return l
+}
+
+func (l *List[T]) lazyInit() {
+	if l.root.next == nil {
+		l.root.next = &l.root
+		l.root.prev = &l.root
+	}
+}
+
+func (l *List[T]) Len() int {
+	return l.length
+}
+
+func (l *List[T]) Front() *Element[T] {
+	if l.length == 0 {
+		return nil
+	}
+	return l.root.next
+}
+
+func (l *List[T]) Back() *Element[T] {
+	if l.length == 0 {
+		return nil
+	}
+	return l.root.prev
+}
+
+// insert links e in after at and returns it
+func (l *List[T]) insert(e, at *Element[T]) *Element[T] {
+	e.prev = at
+	e.next = at.next
+	e.prev.next = e
+	e.next.prev = e
+	e.list = l
+	l.length++
+	return e
+}
+
+// unlink removes e and clears its pointers so a stale element cannot corrupt the list
+func (l *List[T]) unlink(e *Element[T]) {
+	e.prev.next = e.next
+	e.next.prev = e.prev
+	e.next = nil
+	e.prev = nil
+	e.list = nil
+	l.length--
+}
+
+func (l *List[T]) PushFront(v T) *Element[T] {
+	l.lazyInit()
+	return l.insert(&Element[T]{Value: v}, &l.root)
+}
+
+func (l *List[T]) PushBack(v T) *Element[T] {
+	l.lazyInit()
+	return l.insert(&Element[T]{Value: v}, l.root.prev)
+}
+
+// PopFront removes and returns the front value; ok is false if the list is empty
+func (l *List[T]) PopFront() (v T, ok bool) {
+	e := l.Front()
+	if e == nil {
+		return v, false
+	}
+	return l.Remove(e), true
+}
+
+// PopBack removes and returns the back value; ok is false if the list is empty
+func (l *List[T]) PopBack() (v T, ok bool) {
+	e := l.Back()
+	if e == nil {
+		return v, false
+	}
+	return l.Remove(e), true
+}
+
+// InsertBefore inserts v before mark, which must be an element of l, and returns
+// the new element. It returns nil if mark belongs to another list.
+func (l *List[T]) InsertBefore(v T, mark *Element[T]) *Element[T] {
+	if mark.list != l {
+		return nil
+	}
+	return l.insert(&Element[T]{Value: v}, mark.prev)
+}
+
+// InsertAfter inserts v after mark, which must be an element of l, and returns
+// the new element. It returns nil if mark belongs to another list.
+func (l *List[T]) InsertAfter(v T, mark *Element[T]) *Element[T] {
+	if mark.list != l {
+		return nil
+	}
+	return l.insert(&Element[T]{Value: v}, mark)
+}
+
+// Remove unlinks e from l if it is an element of l and returns its value
+func (l *List[T]) Remove(e *Element[T]) T {
+	if e.list == l {
+		l.unlink(e)
+	}
+	return e.Value
+}
+
+// MoveToFront moves e, which must be an element of l, to the front in O(1)
+func (l *List[T]) MoveToFront(e *Element[T]) {
+	if e.list != l || l.root.next == e {
+		return
+	}
+	l.unlink(e)
+	l.insert(e, &l.root)
+}
+
+// MoveToBack moves e, which must be an element of l, to the back in O(1)
+func (l *List[T]) MoveToBack(e *Element[T]) {
+	if e.list != l || l.root.prev == e {
+		return
+	}
+	l.unlink(e)
+	l.insert(e, l.root.prev)
+}
+
+// All yields the values from front to back
+func (l *List[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for e := l.Front(); e != nil; e = e.Next() {
+			if !yield(e.Value) {
+				return
+			}
+		}
+	}
+}
+
+// Backward yields the values from back to front
+func (l *List[T]) Backward() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for e := l.Back(); e != nil; e = e.Prev() {
+			if !yield(e.Value) {
+				return
+			}
+		}
+	}
+}
+
+func (l *List[T]) ToSlice() []T {
+	result := make([]T, 0, l.length)
+	for v := range l.All() {
+		result = append(result, v)
+	}
+	return result
+}
+
+/**
+ * EPIJ 7.1 Merge two sorted lists - moves every element of other into l, leaving
+ * other empty. Both lists must be sorted by less. Equal values keep l's first.
+ *
+ * Time Complexity: O(n+m)
+ * Space Complexity: O(1) reusing nodes
+ */
+func (l *List[T]) MergeSorted(other *List[T], less func(a, b T) bool) {
+	if other == l || other.length == 0 {
+		return
+	}
+	l.lazyInit()
+	current := l.root.next
+	for e := other.Front(); e != nil; {
+		// Advance through l until e belongs before current
+		for current != &l.root && !less(e.Value, current.Value) {
+			current = current.next
+		}
+		next := e.Next()
+		other.unlink(e)
+		l.insert(e, current.prev)
+		e = next
+	}
+}
+
+/**
+ * EPIJ 7.2: Reverse the sublist from position start to finish (1-indexed,
+ * inclusive) in place. Out of range positions are clamped to the list.
+ * Same single pass as reverseSublist: each step moves the element after the
+ * sublist's first element to the front of the sublist.
+ *
+ * Time Complexity: O(finish)
+ * Space Complexity: O(1)
+ */
+func (l *List[T]) ReverseSublist(start, finish int) {
+	start = max(start, 1)
+	finish = min(finish, l.length)
+	if start >= finish {
+		return
+	}
+
+	sublistHead := &l.root
+	for i := 1; i < start; i++ {
+		sublistHead = sublistHead.next
+	}
+
+	sublistIter := sublistHead.next
+	for i := start; i < finish; i++ {
+		temp := sublistIter.next
+		l.unlink(temp)
+		l.insert(temp, sublistHead)
+	}
+}
+
+/**
+ * EPIJ 7.7: Remove k'th last element from the list. removeKthLastElement needs
+ * two iterators because a singly linked list can only be walked forwards; with
+ * prev pointers we simply step k-1 times back from the tail.
+ *
+ * TIME COMPLEXITY: O(k)
+ * SPACE COMPLEXITY: O(1)
+ */
+func (l *List[T]) RemoveKthLast(k int) (v T, ok bool) {
+	if k < 1 || k > l.length {
+		return v, false
+	}
+	e := l.root.prev
+	for i := 1; i < k; i++ {
+		e = e.prev
+	}
+	return l.Remove(e), true
+}
+
+// SElement is a node of a singly linked SList
+type SElement[T any] struct {
+	Value T
+	next  *SElement[T]
+}
+
+// Next returns the following element or nil at the back of the list
+func (e *SElement[T]) Next() *SElement[T] {
+	return e.next
+}
+
+// SList is a singly linked list with O(1) length and a tail pointer, so it
+// supports O(1) push at both ends and pop at the front. Elements carry one
+// pointer instead of three, at the cost of no backward traversal.
+// The zero value is an empty list ready to use.
+type SList[T any] struct {
+	head, tail *SElement[T]
+	length     int
+}
+
+func NewSList[T any](values ...T) *SList[T] {
+	l := &SList[T]{}
+	for _, v := range values {
+		l.PushBack(v)
+	}
+	return l
+}
+
+func (l *SList[T]) Len() int            { return l.length }
+func (l *SList[T]) Front() *SElement[T] { return l.head }
+func (l *SList[T]) Back() *SElement[T]  { return l.tail }
+
+func (l *SList[T]) PushFront(v T) *SElement[T] {
+	e := &SElement[T]{Value: v, next: l.head}
+	l.head = e
+	if l.tail == nil {
+		l.tail = e
+	}
+	l.length++
+	return e
+}
+
+func (l *SList[T]) PushBack(v T) *SElement[T] {
+	e := &SElement[T]{Value: v}
+	if l.tail == nil {
+		l.head = e
+	} else {
+		l.tail.next = e
+	}
+	l.tail = e
+	l.length++
+	return e
+}
+
+func (l *SList[T]) PopFront() (v T, ok bool) {
+	if l.head == nil {
+		return v, false
+	}
+	e := l.head
+	l.head = e.next
+	if l.head == nil {
+		l.tail = nil
+	}
+	e.next = nil
+	l.length--
+	return e.Value, true
+}
+
+// InsertAfter inserts v after mark, which must be an element of l
+func (l *SList[T]) InsertAfter(v T, mark *SElement[T]) *SElement[T] {
+	e := &SElement[T]{Value: v, next: mark.next}
+	mark.next = e
+	if l.tail == mark {
+		l.tail = e
+	}
+	l.length++
+	return e
+}
+
+// RemoveAfter removes the element following mark and returns its value; ok is
+// false if mark is the last element. A singly linked list cannot remove an
+// element in O(1) given only that element, so removal is by predecessor.
+func (l *SList[T]) RemoveAfter(mark *SElement[T]) (v T, ok bool) {
+	e := mark.next
+	if e == nil {
+		return v, false
+	}
+	mark.next = e.next
+	if l.tail == e {
+		l.tail = mark
+	}
+	e.next = nil
+	l.length--
+	return e.Value, true
+}
+
+func (l *SList[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for e := l.head; e != nil; e = e.next {
+			if !yield(e.Value) {
+				return
+			}
+		}
+	}
+}
+
+func (l *SList[T]) ToSlice() []T {
+	result := make([]T, 0, l.length)
+	for v := range l.All() {
+		result = append(result, v)
+	}
+	return result
+}
@@ -0,0 +1,275 @@
+package lists
+
+import (
+	"fmt"
+	"strings"
+)
+
+/**
+ * EPIJ 7.13: Add list-based integers
+ *
+ * ListInt is an arbitrary-precision integer stored as a sign and a list of decimal
+ * digits, least significant digit first, so that addition can walk both lists from
+ * the head carrying into the next node. The magnitude never has leading zeros:
+ * zero is the empty list and is never negative. Operations build new lists and
+ * never modify their operands, so a ListInt can be shared freely.
+ */
+type ListInt struct {
+	negative bool
+	digits   *ListElement
+}
+
+// NewListInt returns the ListInt equal to n
+func NewListInt(n int) *ListInt {
+	// Work on the magnitude as a uint so that math.MinInt does not overflow
+	magnitude := uint(n)
+	if n < 0 {
+		magnitude = -magnitude
+	}
+	dummyHead := &ListElement{}
+	tail := dummyHead
+	for ; magnitude > 0; magnitude /= 10 {
+		tail.Next = &ListElement{Value: int(magnitude % 10)}
+		tail = tail.Next
+	}
+	return &ListInt{negative: n < 0, digits: dummyHead.Next}
+}
+
+// ParseListInt parses an optionally signed decimal string such as "-1234"
+func ParseListInt(s string) (*ListInt, error) {
+	digits := strings.TrimLeft(s, "+-")
+	if len(s)-len(digits) > 1 || digits == "" {
+		return nil, fmt.Errorf("invalid integer %q", s)
+	}
+
+	// Reading left to right meets the most significant digit first, so prepending
+	// each digit leaves the least significant one at the head
+	var head *ListElement
+	for _, r := range digits {
+		if r < '0' || r > '9' {
+			return nil, fmt.Errorf("invalid integer %q", s)
+		}
+		head = &ListElement{Value: int(r - '0'), Next: head}
+	}
+	head = trimLeadingZeros(head)
+	return &ListInt{negative: s[0] == '-' && head != nil, digits: head}, nil
+}
+
+func (x *ListInt) String() string {
+	var digits []byte
+	for d := x.digits; d != nil; d = d.Next {
+		digits = append(digits, byte('0'+d.Value))
+	}
+	if len(digits) == 0 {
+		return "0"
+	}
+
+	var sb strings.Builder
+	if x.negative {
+		sb.WriteByte('-')
+	}
+	for i := len(digits) - 1; i >= 0; i-- {
+		sb.WriteByte(digits[i])
+	}
+	return sb.String()
+}
+
+// Sign returns -1, 0 or +1
+func (x *ListInt) Sign() int {
+	switch {
+	case x.digits == nil:
+		return 0
+	case x.negative:
+		return -1
+	default:
+		return 1
+	}
+}
+
+// Cmp returns -1, 0 or +1 as x is less than, equal to or greater than y
+func (x *ListInt) Cmp(y *ListInt) int {
+	if x.Sign() != y.Sign() {
+		if x.Sign() < y.Sign() {
+			return -1
+		}
+		return 1
+	}
+	if x.negative {
+		return compareDigits(y.digits, x.digits)
+	}
+	return compareDigits(x.digits, y.digits)
+}
+
+// Neg returns -x
+func (x *ListInt) Neg() *ListInt {
+	digits := copyDigits(x.digits)
+	return &ListInt{negative: !x.negative && digits != nil, digits: digits}
+}
+
+// Add returns x + y
+//
+// Time Complexity: O(n+m)
+// Space Complexity: O(max(n, m)) for the result
+func (x *ListInt) Add(y *ListInt) *ListInt {
+	if x.negative == y.negative {
+		return &ListInt{negative: x.negative, digits: addDigits(x.digits, y.digits)}
+	}
+	// Signs differ: subtract the smaller magnitude from the larger and keep the
+	// sign of the larger
+	switch compareDigits(x.digits, y.digits) {
+	case 0:
+		return &ListInt{}
+	case 1:
+		return &ListInt{negative: x.negative, digits: subtractDigits(x.digits, y.digits)}
+	default:
+		return &ListInt{negative: y.negative, digits: subtractDigits(y.digits, x.digits)}
+	}
+}
+
+// Sub returns x - y
+//
+// Time Complexity: O(n+m)
+// Space Complexity: O(max(n, m)) for the result
+func (x *ListInt) Sub(y *ListInt) *ListInt {
+	negated := &ListInt{negative: !y.negative && y.digits != nil, digits: y.digits}
+	return x.Add(negated)
+}
+
+// Mul returns x * y
+//
+// Time Complexity: O(n*m)
+// Space Complexity: O(n+m) for the result
+func (x *ListInt) Mul(y *ListInt) *ListInt {
+	digits := multiplyDigits(x.digits, y.digits)
+	return &ListInt{negative: x.negative != y.negative && digits != nil, digits: digits}
+}
+
+// addDigits adds two magnitudes, least significant digit first, into a new list
+func addDigits(l1, l2 *ListElement) *ListElement {
+	dummyHead := &ListElement{}
+	tail := dummyHead
+	carry := 0
+
+	for l1 != nil || l2 != nil || carry > 0 {
+		sum := carry
+		if l1 != nil {
+			sum += l1.Value
+			l1 = l1.Next
+		}
+		if l2 != nil {
+			sum += l2.Value
+			l2 = l2.Next
+		}
+		tail.Next = &ListElement{Value: sum % 10}
+		carry = sum / 10
+		tail = tail.Next
+	}
+	return dummyHead.Next
+}
+
+// subtractDigits returns the magnitude l1 - l2 as a new list; l1 must be >= l2
+func subtractDigits(l1, l2 *ListElement) *ListElement {
+	dummyHead := &ListElement{}
+	tail := dummyHead
+	borrow := 0
+
+	for l1 != nil {
+		difference := l1.Value - borrow
+		if l2 != nil {
+			difference -= l2.Value
+			l2 = l2.Next
+		}
+		borrow = 0
+		if difference < 0 {
+			difference += 10
+			borrow = 1
+		}
+		tail.Next = &ListElement{Value: difference}
+		tail = tail.Next
+		l1 = l1.Next
+	}
+	return trimLeadingZeros(dummyHead.Next)
+}
+
+/**
+ * multiplyDigits is grade-school multiplication done in place on the result list:
+ * the product of l1 and the i'th digit of l2 is accumulated into the result
+ * starting at its i'th node, growing the list only when a row runs past its end.
+ */
+func multiplyDigits(l1, l2 *ListElement) *ListElement {
+	if l1 == nil || l2 == nil {
+		return nil
+	}
+
+	result := &ListElement{}
+	rowStart := result
+	for d2 := l2; d2 != nil; d2 = d2.Next {
+		carry := 0
+		current, last := rowStart, rowStart
+		for d1 := l1; d1 != nil || carry > 0; {
+			if current == nil {
+				current = &ListElement{}
+				last.Next = current
+			}
+			product := current.Value + carry
+			if d1 != nil {
+				product += d1.Value * d2.Value
+				d1 = d1.Next
+			}
+			current.Value = product % 10
+			carry = product / 10
+			last, current = current, current.Next
+		}
+
+		if rowStart.Next == nil && d2.Next != nil {
+			rowStart.Next = &ListElement{}
+		}
+		rowStart = rowStart.Next
+	}
+	return trimLeadingZeros(result)
+}
+
+// compareDigits compares two magnitudes without leading zeros. A longer list is
+// larger; for equal lengths the most significant differing digit decides, which
+// is the last one seen walking from the head.
+func compareDigits(l1, l2 *ListElement) int {
+	result := 0
+	for l1 != nil && l2 != nil {
+		if l1.Value != l2.Value {
+			if l1.Value < l2.Value {
+				result = -1
+			} else {
+				result = 1
+			}
+		}
+		l1, l2 = l1.Next, l2.Next
+	}
+	switch {
+	case l1 != nil:
+		return 1
+	case l2 != nil:
+		return -1
+	default:
+		return result
+	}
+}
+
+func copyDigits(head *ListElement) *ListElement {
+	return addDigits(head, nil)
+}
+
+// trimLeadingZeros cuts the zero digits off the most significant end, which is
+// the tail of the list, returning nil if every digit is zero
+func trimLeadingZeros(head *ListElement) *ListElement {
+	var lastNonZero *ListElement
+	for d := head; d != nil; d = d.Next {
+		if d.Value != 0 {
+			lastNonZero = d
+		}
+	}
+	if lastNonZero == nil {
+		return nil
+	}
+	lastNonZero.Next = nil
+	return head
+}
@@ -0,0 +1,124 @@
+package lists
+
+import (
+	"math"
+	"math/big"
+	"math/rand"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func mustParseListInt(t *testing.T, s string) *ListInt {
+	t.Helper()
+	x, err := ParseListInt(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return x
+}
+
+func TestParseListInt(t *testing.T) {
+	tests := map[string]string{
+		"0":        "0",
+		"-0":       "0",
+		"+42":      "42",
+		"000123":   "123",
+		"-000":     "0",
+		"-0012300": "-12300",
+		"9":        "9",
+	}
+	for input, want := range tests {
+		assert.Equal(t, want, mustParseListInt(t, input).String(), input)
+	}
+	assert.Equal(t, 0, mustParseListInt(t, "-0").Sign())
+
+	for _, bad := range []string{"", "-", "+", "--1", "+-1", "12a", "1 2", "٣"} {
+		_, err := ParseListInt(bad)
+		assert.Error(t, err, bad)
+	}
+}
+
+func TestNewListInt(t *testing.T) {
+	for _, n := range []int{0, 1, -1, 10, 907, -5000, math.MaxInt, math.MinInt} {
+		assert.Equal(t, strconv.Itoa(n), NewListInt(n).String())
+	}
+}
+
+func TestListIntDigitsAreLeastSignificantFirst(t *testing.T) {
+	x := mustParseListInt(t, "413")
+	assert.Equal(t, []int{3, 1, 4}, listValues(x.digits))
+}
+
+func TestListIntArithmetic(t *testing.T) {
+	a := NewListInt(999)
+	b := NewListInt(1)
+	assert.Equal(t, "1000", a.Add(b).String())
+	assert.Equal(t, "998", a.Sub(b).String())
+	assert.Equal(t, "-998", b.Sub(a).String())
+	assert.Equal(t, "0", a.Sub(a).String())
+	assert.Equal(t, 0, a.Sub(a).Sign())
+	assert.Equal(t, "998001", a.Mul(a).String())
+	assert.Equal(t, "0", a.Mul(NewListInt(0)).String())
+	assert.Equal(t, 0, a.Neg().Mul(NewListInt(0)).Sign())
+	assert.Equal(t, "-999", a.Neg().String())
+	assert.Equal(t, "0", NewListInt(0).Neg().String())
+
+	// Operands are never modified
+	assert.Equal(t, "999", a.String())
+	assert.Equal(t, "1", b.String())
+}
+
+func TestListIntCmp(t *testing.T) {
+	ordered := []int{-1000, -999, -10, -1, 0, 1, 9, 10, 19, 91, 100}
+	for i, x := range ordered {
+		for j, y := range ordered {
+			want := 0
+			if i < j {
+				want = -1
+			} else if i > j {
+				want = 1
+			}
+			assert.Equal(t, want, NewListInt(x).Cmp(NewListInt(y)), "%d vs %d", x, y)
+		}
+	}
+}
+
+func randomDecimal(r *rand.Rand) string {
+	var sb strings.Builder
+	if r.Intn(2) == 0 {
+		sb.WriteByte('-')
+	}
+	length := 1 + r.Intn(40)
+	for range length {
+		// Bias towards 0 and 9 to exercise carries and borrows
+		switch r.Intn(4) {
+		case 0:
+			sb.WriteByte('0')
+		case 1:
+			sb.WriteByte('9')
+		default:
+			sb.WriteByte(byte('0' + r.Intn(10)))
+		}
+	}
+	return sb.String()
+}
+
+func TestListIntAgainstMathBig(t *testing.T) {
+	r := rand.New(rand.NewSource(7))
+	for range 2000 {
+		xs, ys := randomDecimal(r), randomDecimal(r)
+		x, y := mustParseListInt(t, xs), mustParseListInt(t, ys)
+		bx, _ := new(big.Int).SetString(xs, 10)
+		by, _ := new(big.Int).SetString(ys, 10)
+
+		assert.Equal(t, bx.String(), x.String())
+		assert.Equal(t, new(big.Int).Add(bx, by).String(), x.Add(y).String(), "%s + %s", xs, ys)
+		assert.Equal(t, new(big.Int).Sub(bx, by).String(), x.Sub(y).String(), "%s - %s", xs, ys)
+		assert.Equal(t, new(big.Int).Mul(bx, by).String(), x.Mul(y).String(), "%s * %s", xs, ys)
+		assert.Equal(t, bx.Cmp(by), x.Cmp(y), "cmp(%s, %s)", xs, ys)
+		assert.Equal(t, bx.Sign(), x.Sign())
+	}
+}
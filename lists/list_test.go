@@ -0,0 +1,204 @@
+package lists
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListPushAndPop(t *testing.T) {
+	var l List[int]
+	assert.Equal(t, 0, l.Len())
+	assert.Nil(t, l.Front())
+	assert.Nil(t, l.Back())
+
+	l.PushBack(2)
+	l.PushBack(3)
+	l.PushFront(1)
+	assert.Equal(t, 3, l.Len())
+	assert.Equal(t, []int{1, 2, 3}, l.ToSlice())
+	assert.Equal(t, 1, l.Front().Value)
+	assert.Equal(t, 3, l.Back().Value)
+
+	v, ok := l.PopFront()
+	assert.True(t, ok)
+	assert.Equal(t, 1, v)
+	v, ok = l.PopBack()
+	assert.True(t, ok)
+	assert.Equal(t, 3, v)
+	v, ok = l.PopBack()
+	assert.True(t, ok)
+	assert.Equal(t, 2, v)
+
+	_, ok = l.PopFront()
+	assert.False(t, ok)
+	_, ok = l.PopBack()
+	assert.False(t, ok)
+	assert.Equal(t, 0, l.Len())
+}
+
+func TestListInsertAndRemove(t *testing.T) {
+	l := NewList("b", "d")
+	b := l.Front()
+	d := l.Back()
+
+	c := l.InsertAfter("c", b)
+	a := l.InsertBefore("a", b)
+	l.InsertAfter("e", d)
+	assert.Equal(t, []string{"a", "b", "c", "d", "e"}, l.ToSlice())
+	assert.Equal(t, 5, l.Len())
+	assert.Same(t, a, l.Front())
+	assert.Nil(t, a.Prev())
+	assert.Same(t, c, b.Next())
+	assert.Same(t, b, c.Prev())
+
+	assert.Equal(t, "c", l.Remove(c))
+	assert.Equal(t, []string{"a", "b", "d", "e"}, l.ToSlice())
+	assert.Nil(t, c.Next())
+
+	// Removing twice, or through another list, is a no-op
+	l.Remove(c)
+	other := NewList("x")
+	other.Remove(b)
+	assert.Nil(t, other.InsertAfter("y", b))
+	assert.Equal(t, 4, l.Len())
+	assert.Equal(t, []string{"x"}, other.ToSlice())
+}
+
+func TestListMove(t *testing.T) {
+	l := NewList(1, 2, 3)
+	two := l.Front().Next()
+	l.MoveToFront(two)
+	assert.Equal(t, []int{2, 1, 3}, l.ToSlice())
+	l.MoveToFront(two)
+	assert.Equal(t, []int{2, 1, 3}, l.ToSlice())
+	l.MoveToBack(two)
+	assert.Equal(t, []int{1, 3, 2}, l.ToSlice())
+	assert.Equal(t, []int{2, 3, 1}, slices.Collect(l.Backward()))
+	assert.Equal(t, 3, l.Len())
+
+	// Elements of another list are ignored
+	other := NewList(9)
+	l.MoveToFront(other.Front())
+	assert.Equal(t, []int{1, 3, 2}, l.ToSlice())
+}
+
+func TestListIterators(t *testing.T) {
+	l := NewList(1, 2, 3, 4)
+	assert.Equal(t, []int{1, 2, 3, 4}, slices.Collect(l.All()))
+	assert.Equal(t, []int{4, 3, 2, 1}, slices.Collect(l.Backward()))
+
+	var firstTwo []int
+	for v := range l.All() {
+		if len(firstTwo) == 2 {
+			break
+		}
+		firstTwo = append(firstTwo, v)
+	}
+	assert.Equal(t, []int{1, 2}, firstTwo)
+
+	var empty List[int]
+	assert.Empty(t, slices.Collect(empty.Backward()))
+	assert.Equal(t, []int{}, empty.ToSlice())
+}
+
+func TestListMergeSorted(t *testing.T) {
+	less := func(a, b int) bool { return a < b }
+
+	l1 := NewList(1, 3, 5, 7)
+	l2 := NewList(0, 2, 3, 8, 9)
+	three := l2.Front().Next().Next()
+	l1.MergeSorted(l2, less)
+	assert.Equal(t, []int{0, 1, 2, 3, 3, 5, 7, 8, 9}, l1.ToSlice())
+	assert.Equal(t, []int{9, 8, 7, 5, 3, 3, 2, 1, 0}, slices.Collect(l1.Backward()))
+	assert.Equal(t, 9, l1.Len())
+	assert.Equal(t, 0, l2.Len())
+
+	// Nodes are moved rather than copied, and ties keep l1's element first
+	assert.Same(t, l1, three.list)
+	assert.Equal(t, 3, three.Prev().Value)
+	assert.NotSame(t, three, three.Prev())
+
+	var empty List[int]
+	empty.MergeSorted(NewList(1, 2), less)
+	assert.Equal(t, []int{1, 2}, empty.ToSlice())
+
+	empty.MergeSorted(&List[int]{}, less)
+	empty.MergeSorted(&empty, less)
+	assert.Equal(t, []int{1, 2}, empty.ToSlice())
+}
+
+func TestListReverseSublist(t *testing.T) {
+	l := NewList(1, 2, 3, 4, 5)
+	l.ReverseSublist(2, 4)
+	assert.Equal(t, []int{1, 4, 3, 2, 5}, l.ToSlice())
+	assert.Equal(t, []int{5, 2, 3, 4, 1}, slices.Collect(l.Backward()))
+
+	l.ReverseSublist(1, 5)
+	assert.Equal(t, []int{5, 2, 3, 4, 1}, l.ToSlice())
+
+	l.ReverseSublist(3, 3)
+	assert.Equal(t, []int{5, 2, 3, 4, 1}, l.ToSlice())
+
+	l.ReverseSublist(0, 99) // clamped to the whole list
+	assert.Equal(t, []int{1, 4, 3, 2, 5}, l.ToSlice())
+	assert.Equal(t, 5, l.Len())
+
+	var empty List[int]
+	empty.ReverseSublist(1, 2)
+	assert.Equal(t, 0, empty.Len())
+}
+
+func TestListRemoveKthLast(t *testing.T) {
+	l := NewList(1, 2, 3, 4, 5)
+	v, ok := l.RemoveKthLast(2)
+	assert.True(t, ok)
+	assert.Equal(t, 4, v)
+	assert.Equal(t, []int{1, 2, 3, 5}, l.ToSlice())
+
+	v, ok = l.RemoveKthLast(4)
+	assert.True(t, ok)
+	assert.Equal(t, 1, v)
+
+	_, ok = l.RemoveKthLast(4)
+	assert.False(t, ok)
+	_, ok = l.RemoveKthLast(0)
+	assert.False(t, ok)
+	assert.Equal(t, []int{2, 3, 5}, l.ToSlice())
+}
+
+func TestSList(t *testing.T) {
+	var l SList[int]
+	assert.Nil(t, l.Front())
+	_, ok := l.PopFront()
+	assert.False(t, ok)
+
+	l.PushBack(2)
+	l.PushFront(1)
+	three := l.PushBack(3)
+	l.InsertAfter(4, three)
+	assert.Equal(t, []int{1, 2, 3, 4}, l.ToSlice())
+	assert.Equal(t, 4, l.Back().Value)
+	assert.Equal(t, 4, l.Len())
+
+	v, ok := l.RemoveAfter(three)
+	assert.True(t, ok)
+	assert.Equal(t, 4, v)
+	assert.Same(t, three, l.Back())
+	_, ok = l.RemoveAfter(three)
+	assert.False(t, ok)
+
+	v, ok = l.PopFront()
+	assert.True(t, ok)
+	assert.Equal(t, 1, v)
+	assert.Equal(t, 2, l.Front().Value)
+	assert.Equal(t, 3, l.Front().Next().Value)
+
+	l.PopFront()
+	l.PopFront()
+	assert.Nil(t, l.Back())
+	assert.Equal(t, 0, l.Len())
+
+	assert.Equal(t, []string{"a", "b"}, slices.Collect(NewSList("a", "b").All()))
+}
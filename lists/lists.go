@@ -129,6 +129,45 @@ func hasCycle(head *ListElement) bool {
 	return false
 }
 
+/**
+ * EPIJ 7.3 (variant): Find the start of the cycle and its length
+ *  Run slow and fast iterators as in hasCycle. Once they meet inside the cycle,
+ *  walk one of them around until it returns to compute the cycle length C.
+ *  Then start two iterators at the head, one C steps ahead of the other, and
+ *  advance both one step at a time: they meet exactly at the start of the cycle.
+ *
+ *  Returns nil and 0 if the list has no cycle.
+ *
+ *  Time Complexity: O(n)
+ *  Space Complexity: O(1)
+ */
+func cycleStart(head *ListElement) (*ListElement, int) {
+	slow, fast := head, head
+
+	for fast != nil && fast.Next != nil {
+		slow = slow.Next
+		fast = fast.Next.Next
+		if slow == fast {
+			cycleLength := 1
+			for iter := slow.Next; iter != slow; iter = iter.Next {
+				cycleLength++
+			}
+
+			ahead := head
+			for i := 0; i < cycleLength; i++ {
+				ahead = ahead.Next
+			}
+			behind := head
+			for behind != ahead {
+				behind = behind.Next
+				ahead = ahead.Next
+			}
+			return behind, cycleLength
+		}
+	}
+	return nil, 0
+}
+
 /**
  * EPIJ 7.4: Test for overlapping lists - lists are cycle free
  *
@@ -161,6 +200,104 @@ func overlappingLists(l1, l2 *ListElement) bool {
 	return iter1 == iter2
 }
 
+/**
+ * EPIJ 7.4 (variant): Return the first node common to two cycle-free lists
+ *
+ * Once the lists converge they share every later node, so after advancing the
+ * longer list by the difference in lengths both iterators are the same distance
+ * from the shared tail and meet at the first common node.
+ *
+ * Time Complexity: O(n) Space Complexity: O(1)
+ */
+func firstCommonNode(l1, l2 *ListElement) *ListElement {
+	length1, length2 := listLength(l1), listLength(l2)
+	if length1 < length2 {
+		l1, l2 = l2, l1
+		length1, length2 = length2, length1
+	}
+	for i := 0; i < length1-length2; i++ {
+		l1 = l1.Next
+	}
+	for l1 != l2 {
+		l1 = l1.Next
+		l2 = l2.Next
+	}
+	return l1
+}
+
+func listLength(head *ListElement) int {
+	length := 0
+	for ; head != nil; head = head.Next {
+		length++
+	}
+	return length
+}
+
+/**
+ * EPIJ 7.5: Test for overlapping lists - lists may have cycles
+ *
+ * Returns the first node common to both lists, or nil if they do not overlap.
+ * - Neither list has a cycle: this is the cycle-free case above.
+ * - Only one has a cycle: they cannot overlap, since a shared node would put the
+ *   cycle in both lists.
+ * - Both have cycles: they overlap only if it is the same cycle, which we check by
+ *   walking once around one cycle looking for the other's cycle start.
+ *   If both enter the cycle at the same node, the overlap may begin earlier, so
+ *   we find the first common node on the stems leading to it. Otherwise the lists
+ *   join the cycle at different nodes and either cycle start is a first common node;
+ *   we return l1's.
+ *
+ * Time Complexity: O(n+m) Space Complexity: O(1)
+ */
+func overlappingListsNode(l1, l2 *ListElement) *ListElement {
+	root1, _ := cycleStart(l1)
+	root2, _ := cycleStart(l2)
+
+	if root1 == nil && root2 == nil {
+		return firstCommonNode(l1, l2)
+	}
+	if root1 == nil || root2 == nil {
+		return nil
+	}
+
+	iter := root2.Next
+	for iter != root1 && iter != root2 {
+		iter = iter.Next
+	}
+	if iter != root1 {
+		return nil // two disjoint cycles
+	}
+
+	if root1 != root2 {
+		return root1
+	}
+
+	// Same entry point: compare the stems up to root1
+	stem1, stem2 := distance(l1, root1), distance(l2, root1)
+	if stem1 < stem2 {
+		l1, l2 = l2, l1
+		stem1, stem2 = stem2, stem1
+	}
+	for i := 0; i < stem1-stem2; i++ {
+		l1 = l1.Next
+	}
+	for l1 != l2 {
+		l1 = l1.Next
+		l2 = l2.Next
+	}
+	return l1
+}
+
+// distance counts the steps from a to b, which must be reachable from a
+func distance(a, b *ListElement) int {
+	steps := 0
+	for a != b {
+		a = a.Next
+		steps++
+	}
+	return steps
+}
+
 /**
  * EPIJ 7.7: Remove k'th last elememnt from a list
  *
@@ -209,3 +346,177 @@ func removeDuplicatesFromSortedList(head *ListElement) *ListElement {
 
 	return head
 }
+
+/**
+ * EPIJ 7.9: Implement cyclic right shift for singly linked lists
+ *
+ * Shifting right by k moves the last k nodes to the front. Shifting by the length
+ * is a no-op, so only k mod n matters: find the tail (and n) in one pass, close
+ * the list into a ring, then break the ring n-k nodes after the old tail.
+ * Empty and single-node lists, k <= 0 and any k that is a multiple of the length
+ * return the list unchanged.
+ *
+ * Time Complexity: O(n)
+ * Space Complexity: O(1)
+ */
+func cyclicRightShift(head *ListElement, k int) *ListElement {
+	if head == nil || head.Next == nil || k <= 0 {
+		return head
+	}
+
+	tail, length := head, 1
+	for tail.Next != nil {
+		tail = tail.Next
+		length++
+	}
+
+	k %= length
+	if k == 0 {
+		return head
+	}
+
+	tail.Next = head
+	newTail := tail
+	for i := 0; i < length-k; i++ {
+		newTail = newTail.Next
+	}
+	newHead := newTail.Next
+	newTail.Next = nil
+	return newHead
+}
+
+/**
+ * EPIJ 7.10: Implement even-odd merge
+ *
+ * Reorder the list so the nodes at even positions (0, 2, 4, ...) come first,
+ * followed by the nodes at odd positions, each group keeping its order.
+ * Build the two sublists with a dummy head each, alternating which one the next
+ * node goes to, then append the odd sublist to the even one.
+ * Empty and single-node lists are returned as they are.
+ *
+ * Time Complexity: O(n)
+ * Space Complexity: O(1)
+ */
+func evenOddMerge(head *ListElement) *ListElement {
+	if head == nil || head.Next == nil {
+		return head
+	}
+
+	evenDummy, oddDummy := &ListElement{}, &ListElement{}
+	tails := [2]*ListElement{evenDummy, oddDummy}
+	turn := 0
+	for current := head; current != nil; current = current.Next {
+		tails[turn].Next = current
+		tails[turn] = current
+		turn ^= 1
+	}
+	tails[1].Next = nil
+	tails[0].Next = oddDummy.Next
+	return evenDummy.Next
+}
+
+/**
+ * EPIJ 7.11: Test whether a singly linked list is palindromic
+ *
+ * Brute force copies the values into an array: O(n) space.
+ * Instead find the middle with slow and fast iterators, reverse the second half in
+ * place and compare it with the first half. The second half is reversed back
+ * before returning so the caller's list is left as it was.
+ * Empty and single-node lists are palindromes.
+ *
+ * Time Complexity: O(n)
+ * Space Complexity: O(1)
+ */
+func isPalindromic(head *ListElement) bool {
+	if head == nil || head.Next == nil {
+		return true
+	}
+
+	// slow ends on the last node of the first half; for odd lengths the middle
+	// node belongs to the first half and is never compared
+	slow, fast := head, head
+	for fast.Next != nil && fast.Next.Next != nil {
+		slow = slow.Next
+		fast = fast.Next.Next
+	}
+
+	secondHalf := reverseListConstantStorage(slow.Next)
+	palindromic := true
+	for first, second := head, secondHalf; second != nil; first, second = first.Next, second.Next {
+		if first.Value != second.Value {
+			palindromic = false
+			break
+		}
+	}
+	slow.Next = reverseListConstantStorage(secondHalf)
+	return palindromic
+}
+
+/**
+ * EPIJ 7.12: Implement list pivoting
+ *
+ * Rearrange the list so that nodes with values less than x come first, then nodes
+ * equal to x, then nodes greater than x, keeping the relative order within each
+ * group. Thread each node onto one of three sublists (with dummy heads) in a
+ * single pass and concatenate them. Empty and single-node lists are returned as
+ * they are.
+ *
+ * Time Complexity: O(n)
+ * Space Complexity: O(1)
+ */
+func listPivoting(head *ListElement, x int) *ListElement {
+	if head == nil || head.Next == nil {
+		return head
+	}
+
+	lessDummy, equalDummy, greaterDummy := &ListElement{}, &ListElement{}, &ListElement{}
+	less, equal, greater := lessDummy, equalDummy, greaterDummy
+	for current := head; current != nil; current = current.Next {
+		switch {
+		case current.Value < x:
+			less.Next = current
+			less = current
+		case current.Value == x:
+			equal.Next = current
+			equal = current
+		default:
+			greater.Next = current
+			greater = current
+		}
+	}
+
+	greater.Next = nil
+	equal.Next = greaterDummy.Next
+	less.Next = equalDummy.Next
+	return lessDummy.Next
+}
+
+/**
+ * EPIJ 7.8 (variant): Remove all duplicates from an unsorted list
+ *
+ * Unlike removeDuplicatesFromSortedList, duplicates need not be adjacent, so keep
+ * a set of the values seen so far and unlink every later node whose value is
+ * already in it. The first occurrence of each value survives, in its original
+ * order. Empty and single-node lists have no duplicates.
+ * With O(1) space, each node would instead be compared against every earlier
+ * node, taking O(n^2) time.
+ *
+ * Time Complexity: O(n)
+ * Space Complexity: O(d) for d distinct values
+ */
+func removeAllDuplicates(head *ListElement) *ListElement {
+	if head == nil || head.Next == nil {
+		return head
+	}
+
+	seen := map[int]bool{head.Value: true}
+	for current := head; current.Next != nil; {
+		if seen[current.Next.Value] {
+			current.Next = current.Next.Next
+		} else {
+			seen[current.Next.Value] = true
+			current = current.Next
+		}
+	}
+	return head
+}
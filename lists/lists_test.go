@@ -340,3 +340,208 @@ func TestOverlappingListsBothEmpty(t *testing.T) {
 	// Check if the two lists overlap
 	assert.False(t, overlappingLists(l1, l2))
 }
+
+// buildList links nodes with the given values and returns them in order
+func buildList(values ...int) []*ListElement {
+	nodes := make([]*ListElement, len(values))
+	for i := len(values) - 1; i >= 0; i-- {
+		nodes[i] = &ListElement{Value: values[i]}
+		if i+1 < len(values) {
+			nodes[i].Next = nodes[i+1]
+		}
+	}
+	return nodes
+}
+
+func TestCycleStart(t *testing.T) {
+	nodes := buildList(1, 2, 3, 4, 5, 6)
+	nodes[5].Next = nodes[2] // 1 -> 2 -> [3 -> 4 -> 5 -> 6 -> 3]
+
+	start, length := cycleStart(nodes[0])
+	assert.Same(t, nodes[2], start)
+	assert.Equal(t, 4, length)
+}
+
+func TestCycleStartWholeList(t *testing.T) {
+	nodes := buildList(1, 2, 3)
+	nodes[2].Next = nodes[0]
+
+	start, length := cycleStart(nodes[0])
+	assert.Same(t, nodes[0], start)
+	assert.Equal(t, 3, length)
+}
+
+func TestCycleStartSelfLoop(t *testing.T) {
+	nodes := buildList(1, 2)
+	nodes[1].Next = nodes[1]
+
+	start, length := cycleStart(nodes[0])
+	assert.Same(t, nodes[1], start)
+	assert.Equal(t, 1, length)
+}
+
+func TestCycleStartNoCycle(t *testing.T) {
+	start, length := cycleStart(buildList(1, 2, 3)[0])
+	assert.Nil(t, start)
+	assert.Equal(t, 0, length)
+
+	start, length = cycleStart(nil)
+	assert.Nil(t, start)
+	assert.Equal(t, 0, length)
+}
+
+func TestFirstCommonNode(t *testing.T) {
+	common := buildList(7, 8, 9)
+	l1 := &ListElement{Value: 1, Next: &ListElement{Value: 2, Next: common[0]}}
+	l2 := &ListElement{Value: 5, Next: common[0]}
+
+	assert.Same(t, common[0], firstCommonNode(l1, l2))
+	assert.Same(t, common[0], firstCommonNode(l2, l1))
+	assert.Same(t, common[0], firstCommonNode(common[0], l1))
+	assert.Nil(t, firstCommonNode(buildList(1, 2)[0], buildList(1, 2)[0]))
+	assert.Nil(t, firstCommonNode(nil, l1))
+}
+
+func TestOverlappingListsNodeNoCycles(t *testing.T) {
+	common := buildList(3, 4)
+	l1 := &ListElement{Value: 1, Next: &ListElement{Value: 2, Next: common[0]}}
+	l2 := &ListElement{Value: 5, Next: common[0]}
+	assert.Same(t, common[0], overlappingListsNode(l1, l2))
+	assert.Nil(t, overlappingListsNode(buildList(1)[0], buildList(1)[0]))
+	assert.Nil(t, overlappingListsNode(nil, nil))
+}
+
+func TestOverlappingListsNodeOneCycle(t *testing.T) {
+	cyclic := buildList(1, 2, 3)
+	cyclic[2].Next = cyclic[1]
+	assert.Nil(t, overlappingListsNode(cyclic[0], buildList(4, 5)[0]))
+	assert.Nil(t, overlappingListsNode(buildList(4, 5)[0], cyclic[0]))
+}
+
+func TestOverlappingListsNodeDisjointCycles(t *testing.T) {
+	a := buildList(1, 2)
+	a[1].Next = a[0]
+	b := buildList(3, 4)
+	b[1].Next = b[0]
+	assert.Nil(t, overlappingListsNode(a[0], b[0]))
+}
+
+func TestOverlappingListsNodeSharedStem(t *testing.T) {
+	// l1: 1 -> 2 -> [10 -> 11 -> 12 -> 13 -> 11], l2: 5 -> 6 -> 7 -> 10 ...
+	// The overlap starts at 10, before the cycle at 11
+	shared := buildList(10, 11, 12, 13)
+	shared[3].Next = shared[1]
+	l1 := buildList(1, 2)
+	l1[1].Next = shared[0]
+	l2 := buildList(5, 6, 7)
+	l2[2].Next = shared[0]
+
+	assert.Same(t, shared[0], overlappingListsNode(l1[0], l2[0]))
+	assert.Same(t, shared[0], overlappingListsNode(l2[0], l1[0]))
+}
+
+func TestOverlappingListsNodeDifferentEntryPoints(t *testing.T) {
+	// Both lists feed into the cycle 10 -> 11 -> 12 -> 10 at different nodes
+	cycle := buildList(10, 11, 12)
+	cycle[2].Next = cycle[0]
+	l1 := &ListElement{Value: 1, Next: cycle[0]}
+	l2 := &ListElement{Value: 2, Next: &ListElement{Value: 3, Next: cycle[2]}}
+
+	assert.Same(t, cycle[0], overlappingListsNode(l1, l2))
+	assert.Same(t, cycle[2], overlappingListsNode(l2, l1))
+}
+
+func TestCyclicRightShift(t *testing.T) {
+	tests := []struct {
+		values   []int
+		k        int
+		expected []int
+	}{
+		{[]int{1, 2, 3, 4, 5}, 2, []int{4, 5, 1, 2, 3}},
+		{[]int{1, 2, 3, 4, 5}, 4, []int{2, 3, 4, 5, 1}},
+		{[]int{1, 2, 3, 4, 5}, 5, []int{1, 2, 3, 4, 5}},
+		{[]int{1, 2, 3, 4, 5}, 7, []int{4, 5, 1, 2, 3}}, // k > length
+		{[]int{1, 2, 3, 4, 5}, 0, []int{1, 2, 3, 4, 5}},
+		{[]int{1, 2}, 1, []int{2, 1}},
+		{[]int{1}, 3, []int{1}},
+		{nil, 3, nil},
+	}
+	for _, tt := range tests {
+		nodes := buildList(tt.values...)
+		var head *ListElement
+		if len(nodes) > 0 {
+			head = nodes[0]
+		}
+		assert.Equal(t, tt.expected, listValues(cyclicRightShift(head, tt.k)), "%v shifted by %d", tt.values, tt.k)
+	}
+}
+
+func TestEvenOddMerge(t *testing.T) {
+	assert.Equal(t, []int{0, 2, 4, 1, 3}, listValues(evenOddMerge(buildList(0, 1, 2, 3, 4)[0])))
+	assert.Equal(t, []int{0, 2, 1, 3}, listValues(evenOddMerge(buildList(0, 1, 2, 3)[0])))
+	assert.Equal(t, []int{0, 1}, listValues(evenOddMerge(buildList(0, 1)[0])))
+	assert.Equal(t, []int{7}, listValues(evenOddMerge(buildList(7)[0])))
+	assert.Nil(t, evenOddMerge(nil))
+
+	// Nodes are relinked, not copied
+	nodes := buildList(0, 1, 2)
+	head := evenOddMerge(nodes[0])
+	assert.Same(t, nodes[0], head)
+	assert.Same(t, nodes[2], head.Next)
+	assert.Same(t, nodes[1], head.Next.Next)
+}
+
+func TestIsPalindromic(t *testing.T) {
+	tests := []struct {
+		values   []int
+		expected bool
+	}{
+		{[]int{1, 2, 3, 2, 1}, true},
+		{[]int{1, 2, 2, 1}, true},
+		{[]int{1, 2, 3, 1}, false},
+		{[]int{1, 2, 3}, false},
+		{[]int{1, 1}, true},
+		{[]int{1, 2}, false},
+		{[]int{5}, true},
+	}
+	for _, tt := range tests {
+		nodes := buildList(tt.values...)
+		assert.Equal(t, tt.expected, isPalindromic(nodes[0]), "%v", tt.values)
+		// The list is restored
+		assert.Equal(t, tt.values, listValues(nodes[0]))
+		assert.Nil(t, nodes[len(nodes)-1].Next)
+	}
+	assert.True(t, isPalindromic(nil))
+}
+
+func TestListPivoting(t *testing.T) {
+	head := buildList(3, 2, 2, 11, 7, 5, 11)[0]
+	assert.Equal(t, []int{3, 2, 2, 5, 7, 11, 11}, listValues(listPivoting(head, 7)))
+
+	// No node equals the pivot
+	head = buildList(9, 1, 8, 2)[0]
+	assert.Equal(t, []int{1, 2, 9, 8}, listValues(listPivoting(head, 5)))
+
+	// All on one side
+	head = buildList(4, 3)[0]
+	assert.Equal(t, []int{4, 3}, listValues(listPivoting(head, 10)))
+	head = buildList(4, 3)[0]
+	assert.Equal(t, []int{4, 3}, listValues(listPivoting(head, 0)))
+
+	assert.Equal(t, []int{1}, listValues(listPivoting(buildList(1)[0], 0)))
+	assert.Nil(t, listPivoting(nil, 0))
+}
+
+func TestRemoveAllDuplicates(t *testing.T) {
+	head := buildList(3, 1, 3, 2, 1, 1, 4, 2)[0]
+	assert.Equal(t, []int{3, 1, 2, 4}, listValues(removeAllDuplicates(head)))
+
+	head = buildList(5, 5, 5)[0]
+	assert.Equal(t, []int{5}, listValues(removeAllDuplicates(head)))
+
+	head = buildList(1, 2, 3)[0]
+	assert.Equal(t, []int{1, 2, 3}, listValues(removeAllDuplicates(head)))
+
+	assert.Equal(t, []int{1}, listValues(removeAllDuplicates(buildList(1)[0])))
+	assert.Nil(t, removeAllDuplicates(nil))
+}
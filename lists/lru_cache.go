@@ -0,0 +1,96 @@
+package lists
+
+import "time"
+
+type cacheEntry[K comparable, V any] struct {
+	key     K
+	value   V
+	expires time.Time
+}
+
+// LRUCache is a bounded cache that evicts the least recently used entry.
+// A map finds an entry's list element in O(1) and the list keeps elements in
+// recency order, most recent at the front, so every operation is O(1).
+type LRUCache[K comparable, V any] struct {
+	capacity int
+	items    map[K]*Element[cacheEntry[K, V]]
+	order    List[cacheEntry[K, V]]
+	opts     CacheOptions[K, V]
+	stats    CacheStats
+}
+
+// NewLRUCache returns a cache holding at most capacity entries (at least 1)
+func NewLRUCache[K comparable, V any](capacity int, opts CacheOptions[K, V]) *LRUCache[K, V] {
+	return &LRUCache[K, V]{
+		capacity: max(capacity, 1),
+		items:    make(map[K]*Element[cacheEntry[K, V]]),
+		opts:     opts,
+	}
+}
+
+func (c *LRUCache[K, V]) Get(key K) (V, bool) {
+	e, ok := c.items[key]
+	if ok && c.opts.expired(e.Value.expires) {
+		c.remove(e, EvictedExpired)
+		ok = false
+	}
+	if !ok {
+		c.stats.Misses++
+		var zero V
+		return zero, false
+	}
+	c.stats.Hits++
+	c.order.MoveToFront(e)
+	return e.Value.value, true
+}
+
+func (c *LRUCache[K, V]) Put(key K, value V) {
+	c.PutWithTTL(key, value, c.opts.DefaultTTL)
+}
+
+// PutWithTTL stores value for key, expiring after ttl (never if ttl <= 0).
+// Storing an existing key replaces its value and makes it most recently used.
+func (c *LRUCache[K, V]) PutWithTTL(key K, value V, ttl time.Duration) {
+	entry := cacheEntry[K, V]{key: key, value: value, expires: c.opts.expiry(ttl)}
+	if e, ok := c.items[key]; ok {
+		e.Value = entry
+		c.order.MoveToFront(e)
+		return
+	}
+	if c.order.Len() >= c.capacity {
+		c.evict()
+	}
+	c.items[key] = c.order.PushFront(entry)
+}
+
+// evict drops the least recently used entry, reporting it as expired if its TTL
+// has already passed
+func (c *LRUCache[K, V]) evict() {
+	e := c.order.Back()
+	reason := EvictedCapacity
+	if c.opts.expired(e.Value.expires) {
+		reason = EvictedExpired
+	}
+	c.remove(e, reason)
+}
+
+func (c *LRUCache[K, V]) remove(e *Element[cacheEntry[K, V]], reason EvictionReason) {
+	c.order.Remove(e)
+	delete(c.items, e.Value.key)
+	c.stats.Evictions++
+	c.opts.evicted(e.Value.key, e.Value.value, reason)
+}
+
+func (c *LRUCache[K, V]) Delete(key K) bool {
+	e, ok := c.items[key]
+	if !ok {
+		return false
+	}
+	c.order.Remove(e)
+	delete(c.items, key)
+	return true
+}
+
+func (c *LRUCache[K, V]) Len() int { return c.order.Len() }
+
+func (c *LRUCache[K, V]) Stats() CacheStats { return c.stats }
@@ -0,0 +1,70 @@
+package lists
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLRUCacheEvictsLeastRecentlyUsed(t *testing.T) {
+	var evicted []string
+	c := NewLRUCache(2, CacheOptions[string, int]{
+		OnEvict: func(key string, value int, reason EvictionReason) {
+			assert.Equal(t, EvictedCapacity, reason)
+			evicted = append(evicted, key)
+		},
+	})
+	c.Put("a", 1)
+	c.Put("b", 2)
+	c.Get("a") // b is now least recently used
+	c.Put("c", 3)
+
+	_, ok := c.Get("b")
+	assert.False(t, ok)
+	v, ok := c.Get("a")
+	assert.True(t, ok)
+	assert.Equal(t, 1, v)
+	assert.Equal(t, []string{"b"}, evicted)
+	assert.Equal(t, 2, c.Len())
+}
+
+func TestLRUCachePutRefreshesRecency(t *testing.T) {
+	c := NewLRUCache(2, CacheOptions[string, int]{})
+	c.Put("a", 1)
+	c.Put("b", 2)
+	c.Put("a", 10) // replaces and refreshes a
+	c.Put("c", 3)
+
+	_, ok := c.Get("b")
+	assert.False(t, ok)
+	v, _ := c.Get("a")
+	assert.Equal(t, 10, v)
+}
+
+func TestLRUCacheDelete(t *testing.T) {
+	evictions := 0
+	c := NewLRUCache(2, CacheOptions[int, int]{OnEvict: func(int, int, EvictionReason) { evictions++ }})
+	c.Put(1, 1)
+	assert.True(t, c.Delete(1))
+	assert.False(t, c.Delete(1))
+	assert.Equal(t, 0, c.Len())
+	assert.Equal(t, 0, evictions)
+}
+
+func TestLRUCacheStats(t *testing.T) {
+	c := NewLRUCache(1, CacheOptions[int, int]{})
+	c.Put(1, 1)
+	c.Get(1)
+	c.Get(2)
+	c.Put(2, 2)
+	assert.Equal(t, CacheStats{Hits: 1, Misses: 1, Evictions: 1}, c.Stats())
+}
+
+func TestLRUCacheMinimumCapacity(t *testing.T) {
+	c := NewLRUCache(0, CacheOptions[int, int]{})
+	c.Put(1, 1)
+	c.Put(2, 2)
+	assert.Equal(t, 1, c.Len())
+	_, ok := c.Get(2)
+	assert.True(t, ok)
+}
@@ -0,0 +1,135 @@
+package lists
+
+import "container/heap"
+
+/**
+ * Stable bottom-up merge sort for a linked list
+ *
+ * Top-down merge sort on a list needs O(log n) stack for the recursion. Bottom-up
+ * avoids it: first merge adjacent runs of length 1 into sorted runs of length 2,
+ * then runs of 2 into runs of 4, and so on until one run covers the whole list.
+ * Each pass splits runs off the front of the remaining list and appends the merged
+ * result to a tail pointer, so nodes are only relinked, never allocated.
+ *
+ * Time Complexity: O(n log n)
+ * Space Complexity: O(1)
+ */
+func mergeSortList(head *ListElement) *ListElement {
+	length := listLength(head)
+	dummyHead := &ListElement{Next: head}
+
+	for width := 1; width < length; width *= 2 {
+		tail := dummyHead
+		remaining := dummyHead.Next
+		for remaining != nil {
+			left := remaining
+			right := splitAfter(left, width)
+			remaining = splitAfter(right, width)
+			tail = mergeRuns(left, right, tail)
+		}
+	}
+	return dummyHead.Next
+}
+
+// splitAfter cuts the list after its first n nodes and returns the rest
+func splitAfter(head *ListElement, n int) *ListElement {
+	for i := 1; head != nil && i < n; i++ {
+		head = head.Next
+	}
+	if head == nil {
+		return nil
+	}
+	rest := head.Next
+	head.Next = nil
+	return rest
+}
+
+// mergeRuns appends the stable merge of two sorted runs to tail and returns the
+// new tail. Unlike mergeTwoSortedLists, ties take from l1 so equal values keep
+// their original order.
+func mergeRuns(l1, l2, tail *ListElement) *ListElement {
+	for l1 != nil && l2 != nil {
+		if l1.Value <= l2.Value {
+			tail.Next = l1
+			l1 = l1.Next
+		} else {
+			tail.Next = l2
+			l2 = l2.Next
+		}
+		tail = tail.Next
+	}
+	if l1 != nil {
+		tail.Next = l1
+	} else {
+		tail.Next = l2
+	}
+	for tail.Next != nil {
+		tail = tail.Next
+	}
+	return tail
+}
+
+// listHead is a min-heap entry: the current head of one of the input lists
+type listHead struct {
+	node  *ListElement
+	index int // which input list, to break ties in input order
+}
+
+type listHeap []listHead
+
+func (h listHeap) Len() int { return len(h) }
+
+func (h listHeap) Less(i, j int) bool {
+	if h[i].node.Value != h[j].node.Value {
+		return h[i].node.Value < h[j].node.Value
+	}
+	return h[i].index < h[j].index
+}
+
+func (h listHeap) Swap(i, j int) { h[i], h[j] = h[j], h[i] }
+
+func (h *listHeap) Push(x any) { *h = append(*h, x.(listHead)) }
+
+func (h *listHeap) Pop() any {
+	old := *h
+	n := len(old)
+	item := old[n-1]
+	*h = old[:n-1]
+	return item
+}
+
+/**
+ * K-way merge of sorted lists
+ *
+ * Generalises mergeTwoSortedLists: keep the current head of every list in a
+ * min-heap, repeatedly move the smallest head onto the result and replace it
+ * with its successor. Ties go to the earlier list, so the merge is stable.
+ *
+ * Time Complexity: O(n log k) for n nodes in k lists
+ * Space Complexity: O(k) for the heap, nodes are reused
+ */
+func mergeKSortedLists(lists []*ListElement) *ListElement {
+	h := &listHeap{}
+	for i, l := range lists {
+		if l != nil {
+			*h = append(*h, listHead{node: l, index: i})
+		}
+	}
+	heap.Init(h)
+
+	dummyHead := &ListElement{}
+	tail := dummyHead
+	for h.Len() > 0 {
+		smallest := &(*h)[0]
+		tail.Next = smallest.node
+		tail = tail.Next
+		if smallest.node.Next != nil {
+			smallest.node = smallest.node.Next
+			heap.Fix(h, 0)
+		} else {
+			heap.Pop(h)
+		}
+	}
+	tail.Next = nil
+	return dummyHead.Next
+}
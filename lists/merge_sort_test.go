@@ -0,0 +1,99 @@
+package lists
+
+import (
+	"math/rand"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func listValues(head *ListElement) []int {
+	var result []int
+	for ; head != nil; head = head.Next {
+		result = append(result, head.Value)
+	}
+	return result
+}
+
+func TestMergeSortList(t *testing.T) {
+	head := buildList(5, 2, 9, 1, 5, 6, 3)[0]
+	assert.Equal(t, []int{1, 2, 3, 5, 5, 6, 9}, listValues(mergeSortList(head)))
+}
+
+func TestMergeSortListEmptyAndSingle(t *testing.T) {
+	assert.Nil(t, mergeSortList(nil))
+
+	single := &ListElement{Value: 1}
+	assert.Same(t, single, mergeSortList(single))
+	assert.Nil(t, single.Next)
+}
+
+func TestMergeSortListIsStableAndReusesNodes(t *testing.T) {
+	nodes := buildList(3, 1, 3, 2, 1, 3)
+	sorted := mergeSortList(nodes[0])
+
+	var order []*ListElement
+	for n := sorted; n != nil; n = n.Next {
+		order = append(order, n)
+	}
+	// Equal values appear in their original order, and the nodes are the originals
+	expected := []*ListElement{nodes[1], nodes[4], nodes[3], nodes[0], nodes[2], nodes[5]}
+	assert.Equal(t, len(expected), len(order))
+	for i := range expected {
+		assert.Same(t, expected[i], order[i])
+	}
+}
+
+func TestMergeSortListRandom(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for n := 0; n < 70; n++ {
+		values := make([]int, n)
+		for i := range values {
+			values[i] = r.Intn(20)
+		}
+		var head *ListElement
+		if n > 0 {
+			head = buildList(values...)[0]
+		}
+		expected := slices.Clone(values)
+		slices.Sort(expected)
+		if n == 0 {
+			expected = nil
+		}
+		assert.Equal(t, expected, listValues(mergeSortList(head)))
+	}
+}
+
+func TestMergeKSortedLists(t *testing.T) {
+	lists := []*ListElement{
+		buildList(1, 4, 7)[0],
+		nil,
+		buildList(2, 5, 8)[0],
+		buildList(0, 3, 6, 9, 10)[0],
+	}
+	assert.Equal(t, []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10}, listValues(mergeKSortedLists(lists)))
+}
+
+func TestMergeKSortedListsStableAndReusesNodes(t *testing.T) {
+	a := buildList(1, 2)
+	b := buildList(1, 2)
+	merged := mergeKSortedLists([]*ListElement{a[0], b[0]})
+
+	var order []*ListElement
+	for n := merged; n != nil; n = n.Next {
+		order = append(order, n)
+	}
+	expected := []*ListElement{a[0], b[0], a[1], b[1]}
+	for i := range expected {
+		assert.Same(t, expected[i], order[i])
+	}
+}
+
+func TestMergeKSortedListsEmpty(t *testing.T) {
+	assert.Nil(t, mergeKSortedLists(nil))
+	assert.Nil(t, mergeKSortedLists([]*ListElement{nil, nil}))
+
+	single := buildList(1, 2, 3)
+	assert.Same(t, single[0], mergeKSortedLists([]*ListElement{single[0]}))
+}
@@ -0,0 +1,162 @@
+package lists
+
+import "iter"
+
+const defaultPoolChunkSize = 1024
+
+// NodePool hands out ListElements from large chunks instead of allocating each
+// node on its own, and keeps released nodes on a free list (threaded through their
+// Next pointers) for reuse. A steady-state workload that releases as many nodes as
+// it takes never allocates. A chunk stays in memory as long as any of its nodes is
+// reachable, so a pool suits batches of nodes with similar lifetimes.
+// A NodePool is not safe for concurrent use.
+type NodePool struct {
+	free      *ListElement
+	chunk     []ListElement
+	chunkSize int
+}
+
+// NewNodePool returns a pool that allocates chunkSize nodes at a time; a
+// chunkSize <= 0 uses a default
+func NewNodePool(chunkSize int) *NodePool {
+	if chunkSize <= 0 {
+		chunkSize = defaultPoolChunkSize
+	}
+	return &NodePool{chunkSize: chunkSize}
+}
+
+// Get returns a node holding value with a nil Next
+func (p *NodePool) Get(value int) *ListElement {
+	var n *ListElement
+	if p.free != nil {
+		n = p.free
+		p.free = n.Next
+	} else {
+		if len(p.chunk) == 0 {
+			p.chunk = make([]ListElement, p.chunkSize)
+		}
+		n = &p.chunk[0]
+		p.chunk = p.chunk[1:]
+	}
+	n.Value = value
+	n.Next = nil
+	return n
+}
+
+// Put releases a single node; the caller must not use it afterwards
+func (p *NodePool) Put(n *ListElement) {
+	n.Next = p.free
+	p.free = n
+}
+
+// PutList releases every node of the list starting at head by splicing the whole
+// list onto the free list
+//
+// Time Complexity: O(n) to find the tail
+func (p *NodePool) PutList(head *ListElement) {
+	if head == nil {
+		return
+	}
+	tail := head
+	for tail.Next != nil {
+		tail = tail.Next
+	}
+	tail.Next = p.free
+	p.free = head
+}
+
+// PooledList is a singly linked list of ListElements that takes its nodes from a
+// NodePool and gives them back when they are removed. Front exposes the nodes so
+// the functions in this package can read them, but links must only be changed
+// through the list's methods.
+type PooledList struct {
+	pool       *NodePool
+	head, tail *ListElement
+	length     int
+}
+
+// NewPooledList returns an empty list drawing on pool. Several lists may share
+// one pool.
+func NewPooledList(pool *NodePool) *PooledList {
+	return &PooledList{pool: pool}
+}
+
+func (l *PooledList) Len() int            { return l.length }
+func (l *PooledList) Front() *ListElement { return l.head }
+
+func (l *PooledList) PushFront(v int) *ListElement {
+	n := l.pool.Get(v)
+	n.Next = l.head
+	l.head = n
+	if l.tail == nil {
+		l.tail = n
+	}
+	l.length++
+	return n
+}
+
+func (l *PooledList) PushBack(v int) *ListElement {
+	n := l.pool.Get(v)
+	if l.tail == nil {
+		l.head = n
+	} else {
+		l.tail.Next = n
+	}
+	l.tail = n
+	l.length++
+	return n
+}
+
+// PopFront removes the front value and returns its node to the pool
+func (l *PooledList) PopFront() (int, bool) {
+	n := l.head
+	if n == nil {
+		return 0, false
+	}
+	l.head = n.Next
+	if l.head == nil {
+		l.tail = nil
+	}
+	l.length--
+	v := n.Value
+	l.pool.Put(n)
+	return v, true
+}
+
+// RemoveAfter removes the node following mark, which must belong to l, and
+// returns it to the pool. ok is false if mark is the last node.
+func (l *PooledList) RemoveAfter(mark *ListElement) (int, bool) {
+	n := mark.Next
+	if n == nil {
+		return 0, false
+	}
+	mark.Next = n.Next
+	if l.tail == n {
+		l.tail = mark
+	}
+	l.length--
+	v := n.Value
+	l.pool.Put(n)
+	return v, true
+}
+
+// Clear empties the list, returning all of its nodes to the pool
+//
+// Time Complexity: O(1), as the tail is already known
+func (l *PooledList) Clear() {
+	if l.head != nil {
+		l.tail.Next = l.pool.free
+		l.pool.free = l.head
+	}
+	l.head, l.tail, l.length = nil, nil, 0
+}
+
+func (l *PooledList) All() iter.Seq[int] {
+	return func(yield func(int) bool) {
+		for n := l.head; n != nil; n = n.Next {
+			if !yield(n.Value) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,165 @@
+package lists
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNodePoolReusesReleasedNodes(t *testing.T) {
+	pool := NewNodePool(4)
+	a := pool.Get(1)
+	b := pool.Get(2)
+	a.Next = b
+
+	pool.Put(a)
+	reused := pool.Get(3)
+	assert.Same(t, a, reused)
+	assert.Equal(t, 3, reused.Value)
+	assert.Nil(t, reused.Next)
+
+	// Nodes come from one chunk until it runs out
+	c, d := pool.Get(4), pool.Get(5)
+	assert.NotSame(t, a, c)
+	assert.NotSame(t, b, d)
+
+	head := buildList(7, 8, 9)
+	pool.PutList(head[0])
+	pool.PutList(nil)
+	got := []*ListElement{pool.Get(0), pool.Get(0), pool.Get(0)}
+	assert.Equal(t, head, got)
+}
+
+func TestPooledList(t *testing.T) {
+	pool := NewNodePool(0)
+	l := NewPooledList(pool)
+	_, ok := l.PopFront()
+	assert.False(t, ok)
+
+	l.PushBack(2)
+	l.PushBack(3)
+	l.PushFront(1)
+	last := l.PushBack(4)
+	assert.Equal(t, 4, l.Len())
+	assert.Equal(t, []int{1, 2, 3, 4}, listValues(l.Front()))
+
+	v, ok := l.PopFront()
+	assert.True(t, ok)
+	assert.Equal(t, 1, v)
+
+	// Removing the tail keeps PushBack working
+	third := l.Front().Next
+	v, ok = l.RemoveAfter(third)
+	assert.True(t, ok)
+	assert.Equal(t, 4, v)
+	_, ok = l.RemoveAfter(third)
+	assert.False(t, ok)
+	// The removed node is handed out again
+	assert.Same(t, last, l.PushBack(5))
+	assert.Equal(t, []int{2, 3, 5}, listValues(l.Front()))
+
+	var values []int
+	for v := range l.All() {
+		values = append(values, v)
+	}
+	assert.Equal(t, []int{2, 3, 5}, values)
+
+	l.Clear()
+	assert.Equal(t, 0, l.Len())
+	assert.Nil(t, l.Front())
+	l.PushBack(6)
+	assert.Equal(t, []int{6}, listValues(l.Front()))
+}
+
+func TestPooledListDoesNotAllocateInSteadyState(t *testing.T) {
+	l := NewPooledList(NewNodePool(64))
+	for i := range 64 {
+		l.PushBack(i)
+	}
+	l.Clear()
+
+	allocs := testing.AllocsPerRun(100, func() {
+		for i := range 64 {
+			l.PushBack(i)
+		}
+		for l.Len() > 32 {
+			l.PopFront()
+		}
+		l.Clear()
+	})
+	assert.Zero(t, allocs)
+}
+
+const benchmarkListLength = 1000
+
+func BenchmarkBuildAndDiscardList(b *testing.B) {
+	b.Run("Plain", func(b *testing.B) {
+		b.ReportAllocs()
+		for b.Loop() {
+			var head *ListElement
+			for i := range benchmarkListLength {
+				head = &ListElement{Value: i, Next: head}
+			}
+			if head.Value != benchmarkListLength-1 {
+				b.Fatal("unexpected head")
+			}
+		}
+	})
+	b.Run("Pooled", func(b *testing.B) {
+		b.ReportAllocs()
+		l := NewPooledList(NewNodePool(benchmarkListLength))
+		for b.Loop() {
+			for i := range benchmarkListLength {
+				l.PushFront(i)
+			}
+			if l.Front().Value != benchmarkListLength-1 {
+				b.Fatal("unexpected head")
+			}
+			l.Clear()
+		}
+	})
+	b.Run("Unrolled", func(b *testing.B) {
+		b.ReportAllocs()
+		for b.Loop() {
+			l := NewUnrolledList[int](64)
+			for i := range benchmarkListLength {
+				l.PushBack(i)
+			}
+			if l.Len() != benchmarkListLength {
+				b.Fatal("unexpected length")
+			}
+		}
+	})
+}
+
+func BenchmarkTraverseList(b *testing.B) {
+	var head *ListElement
+	unrolled := NewUnrolledList[int](64)
+	for i := range benchmarkListLength * 100 {
+		head = &ListElement{Value: i, Next: head}
+		unrolled.PushBack(i)
+	}
+
+	b.Run("Plain", func(b *testing.B) {
+		for b.Loop() {
+			sum := 0
+			for n := head; n != nil; n = n.Next {
+				sum += n.Value
+			}
+			if sum == 0 {
+				b.Fatal("empty list")
+			}
+		}
+	})
+	b.Run("Unrolled", func(b *testing.B) {
+		for b.Loop() {
+			sum := 0
+			for v := range unrolled.All() {
+				sum += v
+			}
+			if sum == 0 {
+				b.Fatal("empty list")
+			}
+		}
+	})
+}
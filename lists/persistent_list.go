@@ -0,0 +1,184 @@
+package lists
+
+import "iter"
+
+// PersistentList is an immutable singly linked (cons) list. Operations never
+// modify a node; they return a new version that shares as much of the old one as
+// possible, so every earlier version stays valid. The nil *PersistentList is the
+// empty list and all methods accept it.
+type PersistentList[T any] struct {
+	head   T
+	tail   *PersistentList[T]
+	length int
+}
+
+// NewPersistentList returns a list holding values in order
+func NewPersistentList[T any](values ...T) *PersistentList[T] {
+	var l *PersistentList[T]
+	for i := len(values) - 1; i >= 0; i-- {
+		l = l.Prepend(values[i])
+	}
+	return l
+}
+
+func (l *PersistentList[T]) Len() int {
+	if l == nil {
+		return 0
+	}
+	return l.length
+}
+
+// Prepend returns a new list with v in front of l, sharing all of l
+//
+// Time Complexity: O(1)
+func (l *PersistentList[T]) Prepend(v T) *PersistentList[T] {
+	return &PersistentList[T]{head: v, tail: l, length: l.Len() + 1}
+}
+
+// Head returns the first value; ok is false for the empty list
+func (l *PersistentList[T]) Head() (v T, ok bool) {
+	if l == nil {
+		return v, false
+	}
+	return l.head, true
+}
+
+// Tail returns the list without its first value, which is shared rather than
+// copied. The tail of the empty list is empty.
+//
+// Time Complexity: O(1)
+func (l *PersistentList[T]) Tail() *PersistentList[T] {
+	if l == nil {
+		return nil
+	}
+	return l.tail
+}
+
+// Get returns the value at 0-based index i
+//
+// Time Complexity: O(i)
+func (l *PersistentList[T]) Get(i int) (v T, ok bool) {
+	if i < 0 {
+		return v, false
+	}
+	for ; l != nil; l = l.tail {
+		if i == 0 {
+			return l.head, true
+		}
+		i--
+	}
+	return v, false
+}
+
+/**
+ * Update returns a new list with the value at index i replaced. The i nodes in
+ * front of it are copied - each of them points at a changed node - and
+ * everything after it is shared with l. Out of range indices return l itself.
+ *
+ * Time Complexity: O(i)
+ * Space Complexity: O(i)
+ */
+func (l *PersistentList[T]) Update(i int, v T) *PersistentList[T] {
+	if i < 0 || i >= l.Len() {
+		return l
+	}
+	prefix := make([]T, 0, i)
+	node := l
+	for ; i > 0; i-- {
+		prefix = append(prefix, node.head)
+		node = node.tail
+	}
+	result := node.tail.Prepend(v)
+	for k := len(prefix) - 1; k >= 0; k-- {
+		result = result.Prepend(prefix[k])
+	}
+	return result
+}
+
+// Reverse returns a new list with the values in reverse order. No nodes can be
+// shared since every node's successor changes.
+//
+// Time Complexity: O(n)
+func (l *PersistentList[T]) Reverse() *PersistentList[T] {
+	var result *PersistentList[T]
+	for ; l != nil; l = l.tail {
+		result = result.Prepend(l.head)
+	}
+	return result
+}
+
+func (l *PersistentList[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for node := l; node != nil; node = node.tail {
+			if !yield(node.head) {
+				return
+			}
+		}
+	}
+}
+
+func (l *PersistentList[T]) ToSlice() []T {
+	result := make([]T, 0, l.Len())
+	for v := range l.All() {
+		result = append(result, v)
+	}
+	return result
+}
+
+// PersistentQueue is an immutable FIFO queue built from two persistent lists:
+// values are dequeued from the front of front and enqueued onto the front of
+// rear, which therefore holds the newest value first. Following the banker's
+// queue, rear is never allowed to grow longer than front; when it would, the
+// two are combined as front ++ reverse(rear). Each value is reversed at most once
+// on its way through, so operations are amortized O(1) when versions are used
+// one after another. Repeatedly reusing one old version right before a rebuild
+// repeats the O(n) rebuild each time, because this version rebuilds eagerly
+// rather than with the lazy evaluation Okasaki uses to keep the bound
+// persistent. The zero value is an empty queue.
+type PersistentQueue[T any] struct {
+	front *PersistentList[T]
+	rear  *PersistentList[T]
+}
+
+func (q PersistentQueue[T]) Len() int {
+	return q.front.Len() + q.rear.Len()
+}
+
+// balance restores the invariant rear.Len() <= front.Len()
+func (q PersistentQueue[T]) balance() PersistentQueue[T] {
+	if q.rear.Len() <= q.front.Len() {
+		return q
+	}
+	combined := q.rear.Reverse()
+	values := q.front.ToSlice()
+	for i := len(values) - 1; i >= 0; i-- {
+		combined = combined.Prepend(values[i])
+	}
+	return PersistentQueue[T]{front: combined}
+}
+
+// Enqueue returns a new queue with v at the back
+func (q PersistentQueue[T]) Enqueue(v T) PersistentQueue[T] {
+	return PersistentQueue[T]{front: q.front, rear: q.rear.Prepend(v)}.balance()
+}
+
+// Peek returns the value at the front; ok is false if the queue is empty
+func (q PersistentQueue[T]) Peek() (T, bool) {
+	// The invariant means front is only empty if the whole queue is
+	return q.front.Head()
+}
+
+// Dequeue returns the front value and a new queue without it
+func (q PersistentQueue[T]) Dequeue() (v T, rest PersistentQueue[T], ok bool) {
+	v, ok = q.front.Head()
+	if !ok {
+		return v, q, false
+	}
+	return v, PersistentQueue[T]{front: q.front.Tail(), rear: q.rear}.balance(), true
+}
+
+// ToSlice returns the values from front to back
+func (q PersistentQueue[T]) ToSlice() []T {
+	result := q.front.ToSlice()
+	return append(result, q.rear.Reverse().ToSlice()...)
+}
@@ -0,0 +1,130 @@
+package lists
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPersistentListPrependShares(t *testing.T) {
+	base := NewPersistentList(2, 3)
+	one := base.Prepend(1)
+	zero := base.Prepend(0)
+
+	assert.Equal(t, []int{2, 3}, base.ToSlice())
+	assert.Equal(t, []int{1, 2, 3}, one.ToSlice())
+	assert.Equal(t, []int{0, 2, 3}, zero.ToSlice())
+	assert.Same(t, base, one.Tail())
+	assert.Same(t, base, zero.Tail())
+	assert.Equal(t, 3, one.Len())
+}
+
+func TestPersistentListEmpty(t *testing.T) {
+	var empty *PersistentList[string]
+	assert.Equal(t, 0, empty.Len())
+	assert.Nil(t, empty.Tail())
+	_, ok := empty.Head()
+	assert.False(t, ok)
+	_, ok = empty.Get(0)
+	assert.False(t, ok)
+	assert.Equal(t, []string{}, empty.ToSlice())
+	assert.Nil(t, empty.Reverse())
+	assert.Nil(t, NewPersistentList[string]())
+}
+
+func TestPersistentListUpdate(t *testing.T) {
+	original := NewPersistentList(1, 2, 3, 4, 5)
+	updated := original.Update(2, 30)
+
+	assert.Equal(t, []int{1, 2, 3, 4, 5}, original.ToSlice())
+	assert.Equal(t, []int{1, 2, 30, 4, 5}, updated.ToSlice())
+
+	// The suffix after the updated node is shared, the prefix is not
+	assert.Same(t, original.Tail().Tail().Tail(), updated.Tail().Tail().Tail())
+	assert.NotSame(t, original.Tail(), updated.Tail())
+
+	v, ok := updated.Get(2)
+	assert.True(t, ok)
+	assert.Equal(t, 30, v)
+	_, ok = updated.Get(5)
+	assert.False(t, ok)
+	_, ok = updated.Get(-1)
+	assert.False(t, ok)
+
+	assert.Same(t, original, original.Update(5, 0))
+	assert.Same(t, original, original.Update(-1, 0))
+	assert.Equal(t, []int{9, 2, 3, 4, 5}, original.Update(0, 9).ToSlice())
+}
+
+func TestPersistentListHistoryIsPreserved(t *testing.T) {
+	// Simulate an undo history: every edit keeps all previous versions intact
+	var versions []*PersistentList[string]
+	current := NewPersistentList("a")
+	versions = append(versions, current)
+	current = current.Prepend("b")
+	versions = append(versions, current)
+	current = current.Update(1, "A")
+	versions = append(versions, current)
+	current = current.Tail()
+	versions = append(versions, current)
+
+	assert.Equal(t, []string{"a"}, versions[0].ToSlice())
+	assert.Equal(t, []string{"b", "a"}, versions[1].ToSlice())
+	assert.Equal(t, []string{"b", "A"}, versions[2].ToSlice())
+	assert.Equal(t, []string{"A"}, versions[3].ToSlice())
+	assert.Equal(t, []string{"a", "b"}, versions[1].Reverse().ToSlice())
+	assert.Equal(t, []string{"b", "a"}, versions[1].ToSlice())
+}
+
+func TestPersistentQueue(t *testing.T) {
+	var q PersistentQueue[int]
+	for i := 1; i <= 5; i++ {
+		q = q.Enqueue(i)
+	}
+	assert.Equal(t, 5, q.Len())
+	assert.Equal(t, []int{1, 2, 3, 4, 5}, q.ToSlice())
+
+	v, ok := q.Peek()
+	assert.True(t, ok)
+	assert.Equal(t, 1, v)
+
+	var got []int
+	for rest := q; rest.Len() > 0; {
+		v, rest, ok = rest.Dequeue()
+		assert.True(t, ok)
+		got = append(got, v)
+	}
+	assert.Equal(t, []int{1, 2, 3, 4, 5}, got)
+
+	var empty PersistentQueue[int]
+	_, ok = empty.Peek()
+	assert.False(t, ok)
+	_, rest, ok := empty.Dequeue()
+	assert.False(t, ok)
+	assert.Equal(t, 0, rest.Len())
+}
+
+func TestPersistentQueueVersionsAreIndependent(t *testing.T) {
+	var q0 PersistentQueue[int]
+	q1 := q0.Enqueue(1).Enqueue(2)
+	q2 := q1.Enqueue(3)
+	q3 := q1.Enqueue(30) // branch from the same version
+	_, q4, _ := q2.Dequeue()
+
+	assert.Equal(t, []int{}, q0.ToSlice())
+	assert.Equal(t, []int{1, 2}, q1.ToSlice())
+	assert.Equal(t, []int{1, 2, 3}, q2.ToSlice())
+	assert.Equal(t, []int{1, 2, 30}, q3.ToSlice())
+	assert.Equal(t, []int{2, 3}, q4.ToSlice())
+
+	// Interleaved operations keep FIFO order
+	q := q4
+	for i := 4; i <= 8; i++ {
+		q = q.Enqueue(i)
+		if i%2 == 0 {
+			_, q, _ = q.Dequeue()
+		}
+	}
+	assert.Equal(t, []int{5, 6, 7, 8}, q.ToSlice())
+	assert.Equal(t, []int{2, 3}, q4.ToSlice())
+}
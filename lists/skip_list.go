@@ -0,0 +1,239 @@
+package lists
+
+import (
+	"iter"
+	"math/rand"
+)
+
+// skipListMaxLevel bounds the tower height; with p = 1/4 it suits 4^32 keys
+const skipListMaxLevel = 32
+
+type skipNode[K, V any] struct {
+	key   K
+	value V
+	next  []*skipNode[K, V]
+	// span[i] is how many level 0 steps next[i] skips over, which is what makes
+	// rank queries O(log n). For a nil next it counts the steps to the end.
+	span []int
+}
+
+// SkipList is an ordered map built from linked nodes. Every node is on level 0,
+// a sorted singly linked list, and each node is also promoted to each higher level
+// with probability 1/4, so the upper levels are express lanes that skip most of
+// the list. Searches start at the top level and drop down a level whenever the
+// next key would overshoot, visiting O(log n) nodes on average.
+//
+// Keys are ordered by compare, which returns a negative number, zero or a
+// positive number as a is less than, equal to or greater than b (cmp.Compare
+// works for ordered types). The RNG seed makes the shape, and so performance,
+// reproducible.
+type SkipList[K, V any] struct {
+	head    *skipNode[K, V]
+	level   int
+	length  int
+	compare func(a, b K) int
+	rng     *rand.Rand
+}
+
+func NewSkipList[K, V any](compare func(a, b K) int, seed int64) *SkipList[K, V] {
+	return &SkipList[K, V]{
+		head: &skipNode[K, V]{
+			next: make([]*skipNode[K, V], skipListMaxLevel),
+			span: make([]int, skipListMaxLevel),
+		},
+		level:   1,
+		compare: compare,
+		rng:     rand.New(rand.NewSource(seed)),
+	}
+}
+
+func (s *SkipList[K, V]) Len() int {
+	return s.length
+}
+
+func (s *SkipList[K, V]) randomLevel() int {
+	level := 1
+	for level < skipListMaxLevel && s.rng.Intn(4) == 0 {
+		level++
+	}
+	return level
+}
+
+// findPredecessors returns, for each level, the last node whose key is less than
+// key and that node's position counting the head as 0, so rank[0] is the number
+// of keys less than key
+func (s *SkipList[K, V]) findPredecessors(key K) ([skipListMaxLevel]*skipNode[K, V], [skipListMaxLevel]int) {
+	var update [skipListMaxLevel]*skipNode[K, V]
+	var rank [skipListMaxLevel]int
+	x := s.head
+	for i := s.level - 1; i >= 0; i-- {
+		if i < s.level-1 {
+			rank[i] = rank[i+1]
+		}
+		for x.next[i] != nil && s.compare(x.next[i].key, key) < 0 {
+			rank[i] += x.span[i]
+			x = x.next[i]
+		}
+		update[i] = x
+	}
+	return update, rank
+}
+
+/**
+ * Put inserts key or replaces its value, reporting whether the key was new.
+ * The new node is spliced in after the predecessor found on each of its levels,
+ * and the spans either side of it are split.
+ *
+ * Time Complexity: O(log n) expected
+ * Space Complexity: O(1) expected per key (4/3 pointers on average)
+ */
+func (s *SkipList[K, V]) Put(key K, value V) bool {
+	update, rank := s.findPredecessors(key)
+	if x := update[0].next[0]; x != nil && s.compare(x.key, key) == 0 {
+		x.value = value
+		return false
+	}
+
+	level := s.randomLevel()
+	if level > s.level {
+		for i := s.level; i < level; i++ {
+			rank[i] = 0
+			update[i] = s.head
+			update[i].span[i] = s.length
+		}
+		s.level = level
+	}
+
+	n := &skipNode[K, V]{key: key, value: value, next: make([]*skipNode[K, V], level), span: make([]int, level)}
+	for i := 0; i < level; i++ {
+		n.next[i] = update[i].next[i]
+		update[i].next[i] = n
+		n.span[i] = update[i].span[i] - (rank[0] - rank[i])
+		update[i].span[i] = rank[0] - rank[i] + 1
+	}
+	for i := level; i < s.level; i++ {
+		update[i].span[i]++
+	}
+	s.length++
+	return true
+}
+
+func (s *SkipList[K, V]) Get(key K) (V, bool) {
+	update, _ := s.findPredecessors(key)
+	if x := update[0].next[0]; x != nil && s.compare(x.key, key) == 0 {
+		return x.value, true
+	}
+	var zero V
+	return zero, false
+}
+
+/**
+ * Delete removes key, reporting whether it was present. Each level either
+ * bypasses the removed node, merging its span into the predecessor's, or
+ * passes over it and just gets one step shorter.
+ *
+ * Time Complexity: O(log n) expected
+ * Space Complexity: O(1)
+ */
+func (s *SkipList[K, V]) Delete(key K) bool {
+	update, _ := s.findPredecessors(key)
+	x := update[0].next[0]
+	if x == nil || s.compare(x.key, key) != 0 {
+		return false
+	}
+
+	for i := 0; i < s.level; i++ {
+		if update[i].next[i] == x {
+			update[i].span[i] += x.span[i] - 1
+			update[i].next[i] = x.next[i]
+		} else {
+			update[i].span[i]--
+		}
+	}
+	for s.level > 1 && s.head.next[s.level-1] == nil {
+		s.level--
+	}
+	s.length--
+	return true
+}
+
+// Floor returns the entry with the greatest key less than or equal to key
+func (s *SkipList[K, V]) Floor(key K) (K, V, bool) {
+	x := s.head
+	for i := s.level - 1; i >= 0; i-- {
+		for x.next[i] != nil && s.compare(x.next[i].key, key) <= 0 {
+			x = x.next[i]
+		}
+	}
+	if x == s.head {
+		var k K
+		var v V
+		return k, v, false
+	}
+	return x.key, x.value, true
+}
+
+// Ceiling returns the entry with the least key greater than or equal to key
+func (s *SkipList[K, V]) Ceiling(key K) (K, V, bool) {
+	update, _ := s.findPredecessors(key)
+	if x := update[0].next[0]; x != nil {
+		return x.key, x.value, true
+	}
+	var k K
+	var v V
+	return k, v, false
+}
+
+// Rank returns the number of keys strictly less than key, i.e. the 0-based
+// position key has or would have in sorted order
+//
+// Time Complexity: O(log n) expected
+func (s *SkipList[K, V]) Rank(key K) int {
+	_, rank := s.findPredecessors(key)
+	return rank[0]
+}
+
+// Select returns the entry at 0-based position i in key order, the inverse of Rank
+//
+// Time Complexity: O(log n) expected
+func (s *SkipList[K, V]) Select(i int) (K, V, bool) {
+	if i < 0 || i >= s.length {
+		var k K
+		var v V
+		return k, v, false
+	}
+	x := s.head
+	traversed := 0
+	for level := s.level - 1; level >= 0; level-- {
+		for x.next[level] != nil && traversed+x.span[level] <= i+1 {
+			traversed += x.span[level]
+			x = x.next[level]
+		}
+	}
+	return x.key, x.value, true
+}
+
+// Range yields the entries with lo <= key < hi in key order
+//
+// Time Complexity: O(log n + m) for m entries yielded
+func (s *SkipList[K, V]) Range(lo, hi K) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		update, _ := s.findPredecessors(lo)
+		for x := update[0].next[0]; x != nil && s.compare(x.key, hi) < 0; x = x.next[0] {
+			if !yield(x.key, x.value) {
+				return
+			}
+		}
+	}
+}
+
+// All yields every entry in key order
+func (s *SkipList[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for x := s.head.next[0]; x != nil; x = x.next[0] {
+			if !yield(x.key, x.value) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,238 @@
+package lists
+
+import (
+	"cmp"
+	"math/rand"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSkipListPutGetDelete(t *testing.T) {
+	s := NewSkipList[int, string](cmp.Compare[int], 1)
+	assert.True(t, s.Put(5, "five"))
+	assert.True(t, s.Put(1, "one"))
+	assert.True(t, s.Put(9, "nine"))
+	assert.False(t, s.Put(5, "FIVE")) // replaces
+	assert.Equal(t, 3, s.Len())
+
+	v, ok := s.Get(5)
+	assert.True(t, ok)
+	assert.Equal(t, "FIVE", v)
+	_, ok = s.Get(4)
+	assert.False(t, ok)
+
+	assert.True(t, s.Delete(5))
+	assert.False(t, s.Delete(5))
+	assert.Equal(t, 2, s.Len())
+	_, ok = s.Get(5)
+	assert.False(t, ok)
+}
+
+func TestSkipListFloorCeiling(t *testing.T) {
+	s := NewSkipList[int, int](cmp.Compare[int], 1)
+	for _, k := range []int{10, 20, 30} {
+		s.Put(k, k*10)
+	}
+
+	k, v, ok := s.Floor(25)
+	assert.True(t, ok)
+	assert.Equal(t, 20, k)
+	assert.Equal(t, 200, v)
+	k, _, _ = s.Floor(20)
+	assert.Equal(t, 20, k)
+	_, _, ok = s.Floor(5)
+	assert.False(t, ok)
+
+	k, v, ok = s.Ceiling(25)
+	assert.True(t, ok)
+	assert.Equal(t, 30, k)
+	assert.Equal(t, 300, v)
+	k, _, _ = s.Ceiling(10)
+	assert.Equal(t, 10, k)
+	_, _, ok = s.Ceiling(31)
+	assert.False(t, ok)
+}
+
+func TestSkipListRankAndSelect(t *testing.T) {
+	s := NewSkipList[string, int](strings.Compare, 1)
+	for i, k := range []string{"d", "b", "a", "c", "e"} {
+		s.Put(k, i)
+	}
+	assert.Equal(t, 0, s.Rank("a"))
+	assert.Equal(t, 2, s.Rank("c"))
+	assert.Equal(t, 3, s.Rank("cc"))
+	assert.Equal(t, 5, s.Rank("z"))
+
+	k, v, ok := s.Select(3)
+	assert.True(t, ok)
+	assert.Equal(t, "d", k)
+	assert.Equal(t, 0, v)
+	_, _, ok = s.Select(5)
+	assert.False(t, ok)
+	_, _, ok = s.Select(-1)
+	assert.False(t, ok)
+}
+
+func TestSkipListRange(t *testing.T) {
+	s := NewSkipList[int, int](cmp.Compare[int], 1)
+	for k := 0; k < 20; k += 2 {
+		s.Put(k, -k)
+	}
+
+	var keys []int
+	for k, v := range s.Range(5, 12) {
+		assert.Equal(t, -k, v)
+		keys = append(keys, k)
+	}
+	assert.Equal(t, []int{6, 8, 10}, keys)
+
+	keys = nil
+	for k := range s.Range(0, 100) {
+		if k > 4 {
+			break
+		}
+		keys = append(keys, k)
+	}
+	assert.Equal(t, []int{0, 2, 4}, keys)
+
+	for range s.Range(7, 7) {
+		t.Error("empty range must yield nothing")
+	}
+}
+
+func TestSkipListCustomComparator(t *testing.T) {
+	descending := func(a, b int) int { return cmp.Compare(b, a) }
+	s := NewSkipList[int, bool](descending, 1)
+	for _, k := range []int{3, 1, 2} {
+		s.Put(k, true)
+	}
+	var keys []int
+	for k := range s.All() {
+		keys = append(keys, k)
+	}
+	assert.Equal(t, []int{3, 2, 1}, keys)
+}
+
+func TestSkipListMatchesSortedSlice(t *testing.T) {
+	r := rand.New(rand.NewSource(42))
+	s := NewSkipList[int, int](cmp.Compare[int], 7)
+	model := map[int]int{}
+
+	for i := 0; i < 5000; i++ {
+		k := r.Intn(500)
+		if r.Intn(3) == 0 {
+			_, present := model[k]
+			assert.Equal(t, present, s.Delete(k))
+			delete(model, k)
+		} else {
+			_, present := model[k]
+			assert.Equal(t, !present, s.Put(k, i))
+			model[k] = i
+		}
+
+		if i%250 == 0 {
+			keys := make([]int, 0, len(model))
+			for k := range model {
+				keys = append(keys, k)
+			}
+			slices.Sort(keys)
+			assert.Equal(t, len(keys), s.Len())
+
+			var got []int
+			for k, v := range s.All() {
+				assert.Equal(t, model[k], v)
+				got = append(got, k)
+			}
+			assert.Equal(t, keys, got)
+
+			for probe := -1; probe <= 501; probe += 17 {
+				rank, _ := slices.BinarySearch(keys, probe)
+				assert.Equal(t, rank, s.Rank(probe))
+				if rank < len(keys) {
+					k, _, _ := s.Select(rank)
+					assert.Equal(t, keys[rank], k)
+				}
+			}
+		}
+	}
+}
+
+func TestSkipListSeedIsReproducible(t *testing.T) {
+	shape := func(seed int64) []int {
+		s := NewSkipList[int, int](cmp.Compare[int], seed)
+		for k := 0; k < 100; k++ {
+			s.Put(k, k)
+		}
+		var levels []int
+		for x := s.head.next[0]; x != nil; x = x.next[0] {
+			levels = append(levels, len(x.next))
+		}
+		return levels
+	}
+	assert.Equal(t, shape(3), shape(3))
+	assert.NotEqual(t, shape(3), shape(4))
+}
+
+const benchmarkKeys = 100_000
+
+func benchmarkKeySet() []int {
+	r := rand.New(rand.NewSource(1))
+	keys := make([]int, benchmarkKeys)
+	for i := range keys {
+		keys[i] = r.Int()
+	}
+	return keys
+}
+
+func BenchmarkSkipListInsert(b *testing.B) {
+	keys := benchmarkKeySet()
+	for b.Loop() {
+		s := NewSkipList[int, int](cmp.Compare[int], 1)
+		for _, k := range keys {
+			s.Put(k, k)
+		}
+	}
+}
+
+func BenchmarkSortedSliceInsert(b *testing.B) {
+	keys := benchmarkKeySet()
+	for b.Loop() {
+		var sorted []int
+		for _, k := range keys {
+			i, found := slices.BinarySearch(sorted, k)
+			if !found {
+				sorted = slices.Insert(sorted, i, k)
+			}
+		}
+	}
+}
+
+func BenchmarkSkipListGet(b *testing.B) {
+	keys := benchmarkKeySet()
+	s := NewSkipList[int, int](cmp.Compare[int], 1)
+	for _, k := range keys {
+		s.Put(k, k)
+	}
+	i := 0
+	for b.Loop() {
+		s.Get(keys[i%len(keys)])
+		i++
+	}
+}
+
+func BenchmarkSortedSliceGet(b *testing.B) {
+	keys := benchmarkKeySet()
+	sorted := slices.Clone(keys)
+	slices.Sort(sorted)
+	i := 0
+	for b.Loop() {
+		_, found := slices.BinarySearch(sorted, keys[i%len(keys)])
+		if !found {
+			b.Fatal("missing key")
+		}
+		i++
+	}
+}
@@ -0,0 +1,197 @@
+package lists
+
+import "iter"
+
+type unrolledNode[T any] struct {
+	values []T
+	next   *unrolledNode[T]
+}
+
+// UnrolledList is a singly linked list that stores up to nodeCapacity values in
+// each node. Walking it touches one pointer per node rather than per value and
+// the values of a node sit next to each other in memory, so traversal stays
+// cache friendly even when the nodes are scattered across the heap. Every node
+// except the last is kept at least half full: a full node is split in two on
+// insert and an underfull one borrows from or merges with its successor on
+// removal.
+type UnrolledList[T any] struct {
+	head, tail   *unrolledNode[T]
+	length       int
+	nodeCapacity int
+}
+
+// NewUnrolledList returns an empty list storing up to nodeCapacity values per
+// node; capacities below 2 are raised to 2
+func NewUnrolledList[T any](nodeCapacity int) *UnrolledList[T] {
+	return &UnrolledList[T]{nodeCapacity: max(nodeCapacity, 2)}
+}
+
+func (l *UnrolledList[T]) Len() int {
+	return l.length
+}
+
+func (l *UnrolledList[T]) newNode() *unrolledNode[T] {
+	return &unrolledNode[T]{values: make([]T, 0, l.nodeCapacity)}
+}
+
+// locate returns the node holding index i and i's offset within it
+func (l *UnrolledList[T]) locate(i int) (*unrolledNode[T], int) {
+	n := l.head
+	for i >= len(n.values) {
+		i -= len(n.values)
+		n = n.next
+	}
+	return n, i
+}
+
+// Get returns the value at index i
+//
+// Time Complexity: O(n / nodeCapacity)
+func (l *UnrolledList[T]) Get(i int) (v T, ok bool) {
+	if i < 0 || i >= l.length {
+		return v, false
+	}
+	n, offset := l.locate(i)
+	return n.values[offset], true
+}
+
+// PushBack appends v
+//
+// Time Complexity: O(1), or O(nodeCapacity) when the last node is split
+func (l *UnrolledList[T]) PushBack(v T) {
+	if l.tail == nil || len(l.tail.values) == l.nodeCapacity {
+		// Start a fresh node rather than splitting the full one, so appending
+		// leaves every node but the last one full
+		n := l.newNode()
+		if l.tail == nil {
+			l.head = n
+		} else {
+			l.tail.next = n
+		}
+		l.tail = n
+	}
+	l.tail.values = append(l.tail.values, v)
+	l.length++
+}
+
+/**
+ * Insert v at index i, 0 <= i <= Len(). If the target node is full it is split
+ * in half first, so a node never grows past nodeCapacity.
+ *
+ * Time Complexity: O(n / nodeCapacity + nodeCapacity)
+ */
+func (l *UnrolledList[T]) Insert(i int, v T) bool {
+	if i < 0 || i > l.length {
+		return false
+	}
+	if l.head == nil {
+		l.head = l.newNode()
+		l.tail = l.head
+	}
+
+	// Appending belongs in the last node rather than at offset 0 of a nil node
+	n, offset := l.head, i
+	for offset > len(n.values) || (offset == len(n.values) && offset == l.nodeCapacity && n.next != nil) {
+		offset -= len(n.values)
+		n = n.next
+	}
+
+	if len(n.values) == l.nodeCapacity {
+		half := l.nodeCapacity / 2
+		split := l.newNode()
+		split.values = append(split.values, n.values[half:]...)
+		clear(n.values[half:])
+		n.values = n.values[:half]
+		split.next = n.next
+		n.next = split
+		if l.tail == n {
+			l.tail = split
+		}
+		if offset > half {
+			n, offset = split, offset-half
+		}
+	}
+
+	n.values = append(n.values, v)
+	copy(n.values[offset+1:], n.values[offset:])
+	n.values[offset] = v
+	l.length++
+	return true
+}
+
+/**
+ * Remove the value at index i. A node left less than half full refills from its
+ * successor: it takes values from it if the successor can spare them, and absorbs
+ * it completely otherwise.
+ *
+ * Time Complexity: O(n / nodeCapacity + nodeCapacity)
+ */
+func (l *UnrolledList[T]) Remove(i int) (v T, ok bool) {
+	if i < 0 || i >= l.length {
+		return v, false
+	}
+	var previous *unrolledNode[T]
+	n, offset := l.head, i
+	for offset >= len(n.values) {
+		offset -= len(n.values)
+		previous, n = n, n.next
+	}
+
+	v = n.values[offset]
+	copy(n.values[offset:], n.values[offset+1:])
+	var zero T
+	n.values[len(n.values)-1] = zero
+	n.values = n.values[:len(n.values)-1]
+	l.length--
+
+	half := l.nodeCapacity / 2
+	switch {
+	case len(n.values) == 0:
+		// Only the last node, or any node when half is 1, can run empty
+		if previous == nil {
+			l.head = n.next
+		} else {
+			previous.next = n.next
+		}
+		if l.tail == n {
+			l.tail = previous
+		}
+	case len(n.values) < half && n.next != nil:
+		next := n.next
+		if len(next.values)-1 >= half {
+			// Borrow just enough to reach half without taking the successor below it
+			take := min(half-len(n.values), len(next.values)-half)
+			n.values = append(n.values, next.values[:take]...)
+			remaining := copy(next.values, next.values[take:])
+			clear(next.values[remaining:])
+			next.values = next.values[:remaining]
+		} else {
+			n.values = append(n.values, next.values...)
+			n.next = next.next
+			if l.tail == next {
+				l.tail = n
+			}
+		}
+	}
+	return v, true
+}
+
+func (l *UnrolledList[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for n := l.head; n != nil; n = n.next {
+			for _, v := range n.values {
+				if !yield(v) {
+					return
+				}
+			}
+		}
+	}
+}
+
+func (l *UnrolledList[T]) ToSlice() []T {
+	result := make([]T, 0, l.length)
+	for v := range l.All() {
+		result = append(result, v)
+	}
+	return result
+}
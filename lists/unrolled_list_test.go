@@ -0,0 +1,99 @@
+package lists
+
+import (
+	"math/rand"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnrolledList(t *testing.T) {
+	l := NewUnrolledList[string](4)
+	for _, v := range []string{"a", "b", "c", "d", "e", "f"} {
+		l.PushBack(v)
+	}
+	assert.Equal(t, 6, l.Len())
+	assert.Equal(t, []string{"a", "b", "c", "d", "e", "f"}, l.ToSlice())
+
+	assert.True(t, l.Insert(0, "start"))
+	assert.True(t, l.Insert(3, "mid"))
+	assert.False(t, l.Insert(10, "nowhere"))
+	assert.False(t, l.Insert(-1, "nowhere"))
+	assert.Equal(t, []string{"start", "a", "b", "mid", "c", "d", "e", "f"}, l.ToSlice())
+
+	v, ok := l.Get(3)
+	assert.True(t, ok)
+	assert.Equal(t, "mid", v)
+	_, ok = l.Get(8)
+	assert.False(t, ok)
+
+	v, ok = l.Remove(0)
+	assert.True(t, ok)
+	assert.Equal(t, "start", v)
+	_, ok = l.Remove(7)
+	assert.False(t, ok)
+	assert.Equal(t, []string{"a", "b", "mid", "c", "d", "e", "f"}, l.ToSlice())
+
+	for l.Len() > 0 {
+		l.Remove(l.Len() / 2)
+	}
+	assert.Nil(t, l.head)
+	assert.Nil(t, l.tail)
+	l.PushBack("again")
+	assert.Equal(t, []string{"again"}, l.ToSlice())
+}
+
+// checkUnrolledInvariants verifies that no node is over capacity, that only the
+// last node may be less than half full and that no node is empty
+func checkUnrolledInvariants(t *testing.T, l *UnrolledList[int]) {
+	t.Helper()
+	total := 0
+	var last *unrolledNode[int]
+	for n := l.head; n != nil; n = n.next {
+		last = n
+		total += len(n.values)
+		assert.LessOrEqual(t, len(n.values), l.nodeCapacity)
+		assert.NotEmpty(t, n.values)
+		if n.next != nil {
+			assert.GreaterOrEqual(t, len(n.values), l.nodeCapacity/2)
+		}
+	}
+	assert.Equal(t, l.length, total)
+	assert.Same(t, last, l.tail)
+}
+
+func TestUnrolledListAgainstSlice(t *testing.T) {
+	for _, capacity := range []int{1, 2, 3, 4, 7, 16} {
+		r := rand.New(rand.NewSource(int64(capacity)))
+		l := NewUnrolledList[int](capacity)
+		var model []int
+
+		for step := range 3000 {
+			if r.Intn(10) == 0 {
+				l.PushBack(step)
+				model = append(model, step)
+			} else if len(model) == 0 || r.Intn(5) < 3 {
+				i := r.Intn(len(model) + 1)
+				assert.True(t, l.Insert(i, step))
+				model = slices.Insert(model, i, step)
+			} else {
+				i := r.Intn(len(model))
+				v, ok := l.Remove(i)
+				assert.True(t, ok)
+				assert.Equal(t, model[i], v)
+				model = slices.Delete(model, i, i+1)
+			}
+			if step%100 == 0 {
+				checkUnrolledInvariants(t, l)
+				assert.Equal(t, model, l.ToSlice())
+			}
+		}
+		checkUnrolledInvariants(t, l)
+		assert.Equal(t, len(model), l.Len())
+		for i, want := range model {
+			got, _ := l.Get(i)
+			assert.Equal(t, want, got)
+		}
+	}
+}